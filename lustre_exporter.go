@@ -19,6 +19,7 @@ import (
 	stdlog "log"
 	"net/http"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
@@ -111,11 +112,19 @@ func main() {
 
 	var (
 		clientEnabled       = kingpin.Flag("collector.client", "Set client metric level. Valid levels: [extended, core, disabled]").Default("disabled").Enum("extended", "core", "disabled")
+		clientExtentsTop    = kingpin.Flag("collector.client.extents-top-processes", "Number of processes with the most I/O calls to collect per-process extent histograms for. 0 disables them.").Default("0").Int()
 		genericEnabled      = kingpin.Flag("collector.generic", "Set generic metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		lnetEnabled         = kingpin.Flag("collector.lnet", "Set LNET metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		mdsEnabled          = kingpin.Flag("collector.mds", "Set MDS metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		mdtEnabled          = kingpin.Flag("collector.mdt", "Set MDT metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		mgsEnabled          = kingpin.Flag("collector.mgs", "Set MGS metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
+		quotaEnabled        = kingpin.Flag("collector.quota", "Set Quota metric level. Valid levels: [extended, core, disabled]").Default("disabled").Enum("extended", "core", "disabled")
+		quotaAggregation    = kingpin.Flag("collector.quota.aggregation", "Report the quota usage per target or summed up per filesystem. Valid values: [target, filesystem]").Default("target").Enum("target", "filesystem")
+		quotaIDs            = kingpin.Flag("collector.quota.ids", "Comma-separated list of user, group and project IDs to collect the quota usage for. All IDs are collected if empty.").Default("").String()
+		resolveIDs          = kingpin.Flag("collector.resolve-ids", "Resolve user, group and project IDs of the quota and job metrics to names. Valid values: [disabled, label, replace]").Default("disabled").Enum("disabled", "label", "replace")
+		projectIDFile       = kingpin.Flag("collector.resolve-ids.projid-file", "File mapping project names to project IDs.").Default("/etc/projid").String()
+		idOverrideFile      = kingpin.Flag("collector.resolve-ids.override-file", "File with static names taking precedence, one '{user|group|project}:{id}:{name}' per line.").Default("").String()
+		jobIDPattern        = kingpin.Flag("collector.resolve-ids.jobid-pattern", "Regular expression matching the user and group ID within job IDs with the named groups 'uid' and 'gid'.").Default(`^.+\.(?P<uid>\d+)$`).Regexp()
 		ostEnabled          = kingpin.Flag("collector.ost", "Set OST metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		healthStatusEnabled = kingpin.Flag("collector.health", "Set Health metric level. Valid levels: [extended, core, disabled]").Default("extended").Enum("extended", "core", "disabled")
 		listenAddress       = kingpin.Flag("web.listen-address", "Address to use to expose Lustre metrics.").Default(":9169").String()
@@ -156,8 +165,26 @@ func main() {
 	log.Infof(" - MDS State: %s", sources.MdsEnabled)
 	sources.ClientEnabled = *clientEnabled
 	log.Infof(" - Client State: %s", sources.ClientEnabled)
+	sources.ClientExtentsTopProcesses = *clientExtentsTop
+	log.Infof(" - Client Extents Top Processes: %d", sources.ClientExtentsTopProcesses)
 	sources.GenericEnabled = *genericEnabled
 	log.Infof(" - Generic State: %s", sources.GenericEnabled)
+	sources.QuotaEnabled = *quotaEnabled
+	log.Infof(" - Quota State: %s", sources.QuotaEnabled)
+	sources.QuotaAggregation = *quotaAggregation
+	log.Infof(" - Quota Aggregation: %s", sources.QuotaAggregation)
+	if *quotaIDs != "" {
+		sources.QuotaIDs = strings.Split(*quotaIDs, ",")
+		log.Infof(" - Quota IDs: %s", *quotaIDs)
+	}
+	sources.ResolveIDs = *resolveIDs
+	log.Infof(" - Resolve IDs: %s", sources.ResolveIDs)
+	if sources.ResolveIDs != "disabled" {
+		sources.ProjectIDFile = *projectIDFile
+		sources.IDOverrideFile = *idOverrideFile
+		sources.JobIDPattern = *jobIDPattern
+		log.Infof(" - Job ID Pattern: %s", sources.JobIDPattern)
+	}
 	sources.LnetEnabled = *lnetEnabled
 	log.Infof(" - Lnet State: %s", sources.LnetEnabled)
 	sources.HealthStatusEnabled = *healthStatusEnabled
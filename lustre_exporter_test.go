@@ -44,9 +44,10 @@ type promType struct {
 
 const (
 	// Constants taken from https://github.com/prometheus/client_model/blob/master/go/metrics.pb.go
-	counter = 0
-	gauge   = 1
-	untyped = 3
+	counter   = 0
+	gauge     = 1
+	untyped   = 3
+	histogram = 4
 )
 
 var (
@@ -65,6 +66,7 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
 	case "MDT":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "extended"
@@ -74,6 +76,7 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
 	case "MGS":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -83,6 +86,7 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
 	case "MDS":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -92,6 +96,7 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
 	case "Client":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -101,6 +106,7 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
 	case "Generic":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -110,6 +116,7 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "extended"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
 	case "LNET":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -119,6 +126,7 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "extended"
 		sources.HealthStatusEnabled = "disabled"
+		sources.QuotaEnabled = "disabled"
 	case "Health":
 		sources.OstEnabled = "disabled"
 		sources.MdtEnabled = "disabled"
@@ -128,6 +136,17 @@ func toggleCollectors(target string) {
 		sources.GenericEnabled = "disabled"
 		sources.LnetEnabled = "disabled"
 		sources.HealthStatusEnabled = "extended"
+		sources.QuotaEnabled = "disabled"
+	case "Quota":
+		sources.OstEnabled = "disabled"
+		sources.MdtEnabled = "disabled"
+		sources.MgsEnabled = "disabled"
+		sources.MdsEnabled = "disabled"
+		sources.ClientEnabled = "disabled"
+		sources.GenericEnabled = "disabled"
+		sources.LnetEnabled = "disabled"
+		sources.HealthStatusEnabled = "disabled"
+		sources.QuotaEnabled = "extended"
 	}
 }
 
@@ -139,7 +158,7 @@ func stringAlphabetize(str1 string, str2 string) (int, error) {
 		letterCount = len(str1)
 	}
 
-	for i := 0; i < letterCount-1; i++ {
+	for i := 0; i < letterCount; i++ {
 		if str1[i] == str2[i] {
 			continue
 		} else if str1[i] > str2[i] {
@@ -149,6 +168,12 @@ func stringAlphabetize(str1 string, str2 string) (int, error) {
 		}
 	}
 
+	// A label being a prefix of another one, e.g. 'pool' and 'pool_type', comes first
+	if len(str1) > len(str2) {
+		return 1, nil
+	} else if len(str1) < len(str2) {
+		return 2, nil
+	}
 	return 0, fmt.Errorf("Duplicate label detected: %q", str1)
 }
 
@@ -203,10 +228,11 @@ func blacklisted(blacklist []string, metricName string) bool {
 }
 
 func TestCollector(t *testing.T) {
-	targets := []string{"OST", "MDT", "MGS", "MDS", "Client", "Generic", "LNET", "Health"}
+	targets := []string{"OST", "MDT", "MGS", "MDS", "Client", "Generic", "LNET", "Health", "Quota"}
 	// Override the default file location to the local proc directory
 	sources.ProcLocation = "proc"
 	sources.SysLocation = "sys"
+	sources.DebugLocation = "proc/sys"
 
 	sources.LctlCommandMode = false
 
@@ -320,54 +346,9 @@ func TestCollector(t *testing.T) {
 		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 4.7168396288e+10, false},
 		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 3.1445593088e+10, false},
 		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 3.1445593088e+10, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 23, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 23, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 23, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 4.09674e+06, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000"}}, 3, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 174382, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000"}}, 20244, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 4037, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000"}}, 1577, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000"}}, 925, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000"}}, 579, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 190, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000"}}, 35, false},
 		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 600, false},
 		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 600, false},
 		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 600, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 13, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 13, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 13, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 13, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 10, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0002"}}, 10, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0004"}}, 10, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0006"}}, 10, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 153, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}}, 4.059303e+06, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000"}}, 13817, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 1367, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 157, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000"}}, 58945, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000"}}, 2911, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 358, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000"}}, 154861, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000"}}, 6161, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 679, false},
 		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1.048576e+06, false},
 		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1.048576e+06, false},
 		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1.048576e+06, false},
@@ -452,102 +433,6 @@ func TestCollector(t *testing.T) {
 		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
 		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
 		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}}, 2, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0002"}}, 2, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0004"}}, 2, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0006"}}, 2, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1048576"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2097152"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4194304"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 4, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0002"}}, 4, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0004"}}, 4, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0006"}}, 4, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0000"}}, 12, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0002"}}, 12, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0004"}}, 12, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0006"}}, 12, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1048576"}, {"target", "lustrefs-OST0000"}}, 58945, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "131072"}, {"target", "lustrefs-OST0000"}}, 2911, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16384"}, {"target", "lustrefs-OST0000"}}, 358, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2097152"}, {"target", "lustrefs-OST0000"}}, 154861, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "262144"}, {"target", "lustrefs-OST0000"}}, 6161, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "32768"}, {"target", "lustrefs-OST0000"}}, 679, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0000"}}, 153, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4194304"}, {"target", "lustrefs-OST0000"}}, 4.059303e+06, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "524288"}, {"target", "lustrefs-OST0000"}}, 13817, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "65536"}, {"target", "lustrefs-OST0000"}}, 1367, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0000"}}, 157, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0006"}}, 0, false},
 		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "23"}, {"target", "lustrefs-OST0000"}}, 4096, false},
 		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "24"}, {"target", "lustrefs-OST0000"}}, 4096, false},
 		{"lustre_job_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "25"}, {"target", "lustrefs-OST0000"}}, 4096, false},
@@ -978,53 +863,6 @@ func TestCollector(t *testing.T) {
 		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "setattr"}, {"target", "lustrefs-OST0000"}}, 43, false},
 		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 6, false},
 		{"lustre_job_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"operation", "sync"}, {"target", "lustrefs-OST0000"}}, 8, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000"}}, 23, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002"}}, 23, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004"}}, 23, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006"}}, 23, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000"}}, 153, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 157, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000"}}, 176, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "11"}, {"target", "lustrefs-OST0000"}}, 164, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "12"}, {"target", "lustrefs-OST0000"}}, 187, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "13"}, {"target", "lustrefs-OST0000"}}, 185, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "14"}, {"target", "lustrefs-OST0000"}}, 168, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "15"}, {"target", "lustrefs-OST0000"}}, 168, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 186, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "17"}, {"target", "lustrefs-OST0000"}}, 181, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "18"}, {"target", "lustrefs-OST0000"}}, 170, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "19"}, {"target", "lustrefs-OST0000"}}, 164, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 158, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "20"}, {"target", "lustrefs-OST0000"}}, 187, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "21"}, {"target", "lustrefs-OST0000"}}, 174, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "22"}, {"target", "lustrefs-OST0000"}}, 168, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "23"}, {"target", "lustrefs-OST0000"}}, 178, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "24"}, {"target", "lustrefs-OST0000"}}, 179, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "25"}, {"target", "lustrefs-OST0000"}}, 205, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "26"}, {"target", "lustrefs-OST0000"}}, 192, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "27"}, {"target", "lustrefs-OST0000"}}, 160, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "28"}, {"target", "lustrefs-OST0000"}}, 192, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "29"}, {"target", "lustrefs-OST0000"}}, 192, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000"}}, 200, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "30"}, {"target", "lustrefs-OST0000"}}, 195, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "31"}, {"target", "lustrefs-OST0000"}}, 4.293275e+06, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 156, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000"}}, 175, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000"}}, 163, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000"}}, 185, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 159, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000"}}, 160, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 1, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 1, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 1, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 1, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002"}}, 0, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004"}}, 0, false},
-		{"lustre_io_time_milliseconds_total", "Total time in milliseconds the filesystem has spent processing various object sizes.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006"}}, 0, false},
 		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 5.3215232e+07, false},
 		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
 		{"lustre_exports_dirty_total", "Total number of exports that have been marked dirty", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
@@ -1109,66 +947,10 @@ func TestCollector(t *testing.T) {
 		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "56"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
 		{"lustre_job_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"jobid", "57"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
 		{"lustre_job_cleanup_interval_seconds", "Interval in seconds between cleanup of tuning statistics", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 600, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "131072"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16384"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "262144"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32768"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "524288"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "65536"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io_total", "Total number of operations the filesystem has performed for the given size.", counter, []labelPair{{"component", "ost"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "11"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "12"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "13"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "14"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "15"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "17"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "18"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "19"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "20"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "21"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "22"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "23"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "24"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "25"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "26"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "27"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "28"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "29"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "30"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "31"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_discontiguous_pages_total", "Total number of logical discontinuities per RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000"}}, 0, false},
 		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 3, false},
 		{"lustre_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4096, false},
 		{"lustre_grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
 		{"lustre_recovery_time_hard_seconds", "Maximum timeout 'recover_time_soft' can increment to for a single server", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 900, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_pages_per_bulk_rw_total", "Total number of pages per block RPC.", counter, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000"}}, 0, false},
-		{"lustre_disk_io", "Current number of I/O operations that are processing during the snapshot.", gauge, []labelPair{{"component", "ost"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000"}}, 0, false},
 		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1.048576e+06, false},
 		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
 		{"lustre_degraded", "Binary indicator as to whether or not the pool is degraded - 0 for not degraded, 1 for degraded", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
@@ -1222,6 +1004,428 @@ func TestCollector(t *testing.T) {
 		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 31, false},
 		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 31, false},
 		{"lustre_lock_grant_rate", "Lock grant rate", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 31, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0000"}}, 2, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0002"}}, 2, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0004"}}, 2, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "create"}, {"target", "lustrefs-OST0006"}}, 2, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0000"}}, 35359, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0002"}}, 35354, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0004"}}, 35350, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.2"}, {"component", "ost"}, {"operation", "statfs"}, {"target", "lustrefs-OST0006"}}, 35347, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0000"}}, 140, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0002"}}, 644, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0004"}}, 644, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"operation", "ping"}, {"target", "lustrefs-OST0006"}}, 644, false},
+		{"lustre_client_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1.6552048697344e+13, false},
+		{"lustre_client_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.194304e+06, false},
+		{"lustre_client_write_minimum_size_bytes", "The minimum write size in bytes.", gauge, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4096, false},
+		{"lustre_client_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4.298711e+06, false},
+		{"lustre_discontiguous_pages", "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}}, 23, false},
+		{"lustre_discontiguous_pages", "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}}, 23, false},
+		{"lustre_discontiguous_pages", "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}}, 23, false},
+		{"lustre_discontiguous_pages", "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}}, 23, false},
+		{"lustre_discontiguous_pages", "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}}, 4.298712e+06, false},
+		{"lustre_discontiguous_pages", "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_discontiguous_pages", "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_discontiguous_pages", "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_disk_io_in_flight", "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}}, 23, false},
+		{"lustre_disk_io_in_flight", "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}}, 23, false},
+		{"lustre_disk_io_in_flight", "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}}, 23, false},
+		{"lustre_disk_io_in_flight", "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}}, 23, false},
+		{"lustre_disk_io_in_flight", "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}}, 4.298712e+06, false},
+		{"lustre_disk_io_in_flight", "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_disk_io_in_flight", "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_disk_io_in_flight", "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_disk_io_size_bytes", "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}}, 23, false},
+		{"lustre_disk_io_size_bytes", "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}}, 23, false},
+		{"lustre_disk_io_size_bytes", "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}}, 23, false},
+		{"lustre_disk_io_size_bytes", "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}}, 23, false},
+		{"lustre_disk_io_size_bytes", "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}}, 4.298712e+06, false},
+		{"lustre_disk_io_size_bytes", "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_disk_io_size_bytes", "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_disk_io_size_bytes", "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_io_time_milliseconds", "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_io_time_milliseconds", "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_io_time_milliseconds", "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_io_time_milliseconds", "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_io_time_milliseconds", "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_io_time_milliseconds", "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_io_time_milliseconds", "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_io_time_milliseconds", "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_pages_per_bulk_rw", "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}}, 23, false},
+		{"lustre_pages_per_bulk_rw", "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}}, 23, false},
+		{"lustre_pages_per_bulk_rw", "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}}, 23, false},
+		{"lustre_pages_per_bulk_rw", "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}}, 23, false},
+		{"lustre_pages_per_bulk_rw", "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}}, 4.298712e+06, false},
+		{"lustre_pages_per_bulk_rw", "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_pages_per_bulk_rw", "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_pages_per_bulk_rw", "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "ost"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 4, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 4, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 12, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}}, 4208, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 219468, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 8.137871e+06, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "obd_ping"}, {"service", "ost"}}, 34387, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_connect"}, {"service", "ost"}}, 2064, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_create"}, {"service", "ost"}}, 32095, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_get_info"}, {"service", "ost"}}, 1890, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_punch"}, {"service", "ost_io"}}, 1.386113e+06, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_statfs"}, {"service", "ost_create"}}, 3.571221e+06, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_write"}, {"service", "ost_io"}}, 1.3836421716e+10, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ldlm_extent_enqueue"}, {"service", "ost"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "obd_ping"}, {"service", "ost"}}, 2076, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_connect"}, {"service", "ost"}}, 16, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_create"}, {"service", "ost"}}, 16, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_get_info"}, {"service", "ost"}}, 4, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_punch"}, {"service", "ost_io"}}, 57, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_statfs"}, {"service", "ost_create"}}, 141654, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "ost"}, {"operation", "ost_write"}, {"service", "ost_io"}}, 4.298777e+06, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 59, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 60, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 52, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 3, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 3, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 4, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}}, 18, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 296, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 1751, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 10, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 10, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 31, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 512, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 4702, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 6360, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}}, 92124, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 8.762843e+06, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 1.35895464e+08, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost"}}, 2113, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 141654, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 4.298835e+06, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 248, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 24, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 248, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}}, 24, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}}, 24, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 6, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 4, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 6, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}}, 4, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost"}}, 10, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_create"}}, 8, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_io"}}, 17, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_out"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "ost"}, {"service", "ost_seq"}}, 4, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}}, 1, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_create"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_io"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_out"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ost_seq"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "orr"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_create"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_out"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ost_seq"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "high_priority"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"policy", "trr"}, {"queue", "regular"}, {"service", "ost_io"}}, 0, false},
+		{"lustre_client_ldlm_stats_total", "Number of LDLM lock operations requested by the client.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "ost"}, {"operation", "enqueue"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_recovery_clients", "Number of clients expected to take part in the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_recovery_clients", "Number of clients expected to take part in the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_recovery_clients", "Number of clients expected to take part in the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_recovery_clients", "Number of clients expected to take part in the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_recovery_completed_clients", "Number of clients which completed the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_recovery_completed_clients", "Number of clients which completed the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_recovery_completed_clients", "Number of clients which completed the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_recovery_completed_clients", "Number of clients which completed the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_recovery_duration_seconds", "Duration in seconds of the last completed recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_duration_seconds", "Duration in seconds of the last completed recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_recovery_duration_seconds", "Duration in seconds of the last completed recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_duration_seconds", "Duration in seconds of the last completed recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_recovery_replayed_requests", "Number of requests replayed during the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_replayed_requests", "Number of requests replayed during the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_recovery_replayed_requests", "Number of requests replayed during the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_replayed_requests", "Number of requests replayed during the recovery of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_recovery_start_timestamp_seconds", "Time in seconds since the epoch at which the last recovery of the target started.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1.510605701e+09, false},
+		{"lustre_recovery_start_timestamp_seconds", "Time in seconds since the epoch at which the last recovery of the target started.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1.510605726e+09, false},
+		{"lustre_recovery_start_timestamp_seconds", "Time in seconds since the epoch at which the last recovery of the target started.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1.510605746e+09, false},
+		{"lustre_recovery_start_timestamp_seconds", "Time in seconds since the epoch at which the last recovery of the target started.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1.510605761e+09, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "COMPLETE"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "COMPLETE"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "COMPLETE"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "COMPLETE"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "INACTIVE"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "INACTIVE"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "INACTIVE"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "INACTIVE"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "RECOVERING"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "RECOVERING"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "RECOVERING"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "RECOVERING"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "WAITING"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "WAITING"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "WAITING"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "WAITING"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "dangling"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "dangling"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "dangling"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "dangling"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "inconsistent_owner"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "inconsistent_owner"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "inconsistent_owner"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "inconsistent_owner"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "multiple_referenced"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "multiple_referenced"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "multiple_referenced"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "multiple_referenced"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "orphan"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "orphan"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "orphan"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "orphan"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "others"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "others"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "others"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "others"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "unmatched_pair"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "unmatched_pair"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "unmatched_pair"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "ost"}, {"inconsistency", "unmatched_pair"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "1"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "ost"}, {"phase", "2"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-failed"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-failed"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-failed"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-failed"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-paused"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-paused"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-paused"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-paused"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-stopped"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-stopped"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-stopped"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "co-stopped"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "completed"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "completed"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "completed"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "completed"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "crashed"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "crashed"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "crashed"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "crashed"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "failed"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "failed"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "failed"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "failed"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "init"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 1, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "init"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 1, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "init"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 1, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "init"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 1, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "partial"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "partial"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "partial"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "partial"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "paused"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "paused"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "paused"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "paused"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "scanning-phase1"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "scanning-phase1"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "scanning-phase1"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "scanning-phase1"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "scanning-phase2"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "scanning-phase2"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "scanning-phase2"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "scanning-phase2"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "stopped"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "stopped"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "stopped"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "ost"}, {"status", "stopped"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of successfully completed runs of the LFSCK component.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of successfully completed runs of the LFSCK component.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of successfully completed runs of the LFSCK component.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of successfully completed runs of the LFSCK component.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}, {"type", "layout"}}, 0, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "current"}, {"service", "ost"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "current"}, {"service", "ost_create"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "current"}, {"service", "ost_io"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "current"}, {"service", "ost_out"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "current"}, {"service", "ost_seq"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "worst"}, {"service", "ost"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "worst"}, {"service", "ost_create"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "worst"}, {"service", "ost_io"}}, 31, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "worst"}, {"service", "ost_out"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "ost"}, {"estimate", "worst"}, {"service", "ost_seq"}}, 10, false},
+		{"lustre_site_busy_objects", "Number of objects in the object cache of the target being in use.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 20, false},
+		{"lustre_site_busy_objects", "Number of objects in the object cache of the target being in use.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 20, false},
+		{"lustre_site_busy_objects", "Number of objects in the object cache of the target being in use.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 20, false},
+		{"lustre_site_busy_objects", "Number of objects in the object cache of the target being in use.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 20, false},
+		{"lustre_site_cache_death_races_total", "Total number of lookups of the object cache of the target finding an object being freed.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_site_cache_death_races_total", "Total number of lookups of the object cache of the target finding an object being freed.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_site_cache_death_races_total", "Total number of lookups of the object cache of the target finding an object being freed.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_site_cache_death_races_total", "Total number of lookups of the object cache of the target finding an object being freed.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_site_cache_hits_total", "Total number of lookups of the object cache of the target finding the object.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 8.597582e+06, false},
+		{"lustre_site_cache_hits_total", "Total number of lookups of the object cache of the target finding the object.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 45, false},
+		{"lustre_site_cache_hits_total", "Total number of lookups of the object cache of the target finding the object.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 45, false},
+		{"lustre_site_cache_hits_total", "Total number of lookups of the object cache of the target finding the object.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 45, false},
+		{"lustre_site_cache_misses_total", "Total number of lookups of the object cache of the target not finding the object.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 103, false},
+		{"lustre_site_cache_misses_total", "Total number of lookups of the object cache of the target not finding the object.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 103, false},
+		{"lustre_site_cache_misses_total", "Total number of lookups of the object cache of the target not finding the object.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 103, false},
+		{"lustre_site_cache_misses_total", "Total number of lookups of the object cache of the target not finding the object.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 103, false},
+		{"lustre_site_cache_races_total", "Total number of objects created concurrently in the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_site_cache_races_total", "Total number of objects created concurrently in the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_site_cache_races_total", "Total number of objects created concurrently in the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_site_cache_races_total", "Total number of objects created concurrently in the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_site_hash_buckets", "Number of hash buckets of the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 4096, false},
+		{"lustre_site_hash_buckets", "Number of hash buckets of the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 4096, false},
+		{"lustre_site_hash_buckets", "Number of hash buckets of the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 4096, false},
+		{"lustre_site_hash_buckets", "Number of hash buckets of the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 4096, false},
+		{"lustre_site_hash_buckets_used", "Number of hash buckets of the object cache of the target holding objects.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 56, false},
+		{"lustre_site_hash_buckets_used", "Number of hash buckets of the object cache of the target holding objects.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 56, false},
+		{"lustre_site_hash_buckets_used", "Number of hash buckets of the object cache of the target holding objects.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 56, false},
+		{"lustre_site_hash_buckets_used", "Number of hash buckets of the object cache of the target holding objects.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 56, false},
+		{"lustre_site_hash_maximum_search_depth", "Maximum number of objects searched in a hash bucket of the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_site_hash_maximum_search_depth", "Maximum number of objects searched in a hash bucket of the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_site_hash_maximum_search_depth", "Maximum number of objects searched in a hash bucket of the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_site_hash_maximum_search_depth", "Maximum number of objects searched in a hash bucket of the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_site_lru_purged_total", "Total number of objects purged from the LRU of the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_site_lru_purged_total", "Total number of objects purged from the LRU of the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_site_lru_purged_total", "Total number of objects purged from the LRU of the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_site_lru_purged_total", "Total number of objects purged from the LRU of the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_site_objects", "Number of objects in the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 56, false},
+		{"lustre_site_objects", "Number of objects in the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 56, false},
+		{"lustre_site_objects", "Number of objects in the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 56, false},
+		{"lustre_site_objects", "Number of objects in the object cache of the target.", gauge, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 56, false},
+		{"lustre_site_objects_created_total", "Total number of objects created in the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0000"}}, 104, false},
+		{"lustre_site_objects_created_total", "Total number of objects created in the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0002"}}, 104, false},
+		{"lustre_site_objects_created_total", "Total number of objects created in the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0004"}}, 104, false},
+		{"lustre_site_objects_created_total", "Total number of objects created in the object cache of the target.", counter, []labelPair{{"component", "ost"}, {"target", "lustrefs-OST0006"}}, 104, false},
 
 		// MDT Metrics
 		{"lustre_changelog_current_index", "Changelog current index.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 34, false},
@@ -1482,6 +1686,492 @@ func TestCollector(t *testing.T) {
 		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2.241498368e+09, false},
 		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 4.30405292e+08, false},
 		{"lustre_free_kilobytes", "Number of kilobytes free in the pool", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2.241500416e+09, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "close"}, {"target", "lustrefs-MDT0000"}}, 9, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "getattr"}, {"target", "lustrefs-MDT0000"}}, 16, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "getxattr"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "open"}, {"target", "lustrefs-MDT0000"}}, 10, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "setattr"}, {"target", "lustrefs-MDT0000"}}, 57, false},
+		{"lustre_client_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "statfs"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "mdt"}, {"operation", "mknod"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 300, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 316, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 301, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 325, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 308, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 301, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 304, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 23, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 26, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 23, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 24, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 24, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 24, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 24, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "mdt"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "mdt"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "mdt"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "mdt"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "mdt"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "mdt"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "mdt"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 2, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 22, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 21, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 22, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "network"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "network"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "network"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "network"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "network"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "network"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "network"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "services"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "services"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "services"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "services"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "services"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "services"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "services"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "FULL"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "FULL"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "FULL"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "FULL"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "FULL"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "FULL"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "FULL"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "IDLE"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "IDLE"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "IDLE"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "IDLE"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "IDLE"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "IDLE"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "IDLE"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "NEW"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "NEW"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "NEW"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "NEW"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "NEW"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "NEW"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "NEW"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_active", "Returns '1' if the target is active for object allocation on this MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the target is active for object allocation on this MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the target is active for object allocation on this MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the target is active for object allocation on this MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the target is active for object allocation on this MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the target is active for object allocation on this MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_active", "Returns '1' if the target is active for object allocation on this MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_osp_create_count", "Number of objects precreated on the target by a single request", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects precreated on the target by a single request", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects precreated on the target by a single request", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects precreated on the target by a single request", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects precreated on the target by a single request", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects precreated on the target by a single request", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_create_count", "Number of objects precreated on the target by a single request", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroy requests in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroy requests in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroy requests in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroy requests in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroy requests in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroy requests in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_destroys_in_flight", "Number of object destroy requests in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects precreated on the target by a single request, '0' disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects precreated on the target by a single request, '0' disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects precreated on the target by a single request, '0' disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects precreated on the target by a single request, '0' disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects precreated on the target by a single request, '0' disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects precreated on the target by a single request, '0' disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_create_count", "Maximum number of objects precreated on the target by a single request, '0' disables object creation", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 20000, false},
+		{"lustre_osp_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 8, false},
+		{"lustre_osp_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 8, false},
+		{"lustre_osp_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 8, false},
+		{"lustre_osp_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 8, false},
+		{"lustre_osp_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 8, false},
+		{"lustre_osp_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 8, false},
+		{"lustre_osp_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 8, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 65, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 65, false},
+		{"lustre_osp_prealloc_last_id", "Last object ID precreated on the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 97, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID to be used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 67, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID to be used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID to be used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID to be used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 34, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID to be used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID to be used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 34, false},
+		{"lustre_osp_prealloc_next_id", "Next precreated object ID to be used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 66, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for pending allocations", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for pending allocations", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for pending allocations", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for pending allocations", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for pending allocations", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for pending allocations", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_reserved_objects", "Number of precreated objects reserved for pending allocations", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation, '0' if healthy, otherwise a negative error code such as -28 (ENOSPC)", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation, '0' if healthy, otherwise a negative error code such as -28 (ENOSPC)", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation, '0' if healthy, otherwise a negative error code such as -28 (ENOSPC)", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation, '0' if healthy, otherwise a negative error code such as -28 (ENOSPC)", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation, '0' if healthy, otherwise a negative error code such as -28 (ENOSPC)", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation, '0' if healthy, otherwise a negative error code such as -28 (ENOSPC)", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_prealloc_status", "Status of the object precreation, '0' if healthy, otherwise a negative error code such as -28 (ENOSPC)", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_precreated_objects", "Number of objects precreated on the target and not yet used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 31, false},
+		{"lustre_osp_precreated_objects", "Number of objects precreated on the target and not yet used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_precreated_objects", "Number of objects precreated on the target and not yet used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_precreated_objects", "Number of objects precreated on the target and not yet used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_precreated_objects", "Number of objects precreated on the target and not yet used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_precreated_objects", "Number of objects precreated on the target and not yet used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_precreated_objects", "Number of objects precreated on the target and not yet used by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 32, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes above which object allocation on the target is resumed", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 89967, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes above which object allocation on the target is resumed", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 89967, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes above which object allocation on the target is resumed", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 89967, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes above which object allocation on the target is resumed", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 89967, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes above which object allocation on the target is resumed", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 59977, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes above which object allocation on the target is resumed", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 59977, false},
+		{"lustre_osp_reserved_high_megabytes", "Free space in megabytes above which object allocation on the target is resumed", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 59977, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes below which object allocation on the target is stopped", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 44983, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes below which object allocation on the target is stopped", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 44983, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes below which object allocation on the target is stopped", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 44983, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes below which object allocation on the target is stopped", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 44983, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes below which object allocation on the target is stopped", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 29988, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes below which object allocation on the target is stopped", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 29988, false},
+		{"lustre_osp_reserved_low_megabytes", "Free space in megabytes below which object allocation on the target is stopped", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 29988, false},
+		{"lustre_osp_sync_changes", "Number of changes, such as object destroys, not yet synced to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of changes, such as object destroys, not yet synced to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of changes, such as object destroys, not yet synced to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of changes, such as object destroys, not yet synced to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of changes, such as object destroys, not yet synced to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of changes, such as object destroys, not yet synced to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_changes", "Number of changes, such as object destroys, not yet synced to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of sync RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of sync RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of sync RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of sync RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of sync RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of sync RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_flight", "Number of sync RPCs in flight to the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of sync RPCs being processed by the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of sync RPCs being processed by the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of sync RPCs being processed by the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of sync RPCs being processed by the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of sync RPCs being processed by the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of sync RPCs being processed by the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_sync_in_progress", "Number of sync RPCs being processed by the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_osp_available_kilobytes", "Number of kilobytes readily available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 4.715891712e+10, false},
+		{"lustre_osp_available_kilobytes", "Number of kilobytes readily available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 4.7168357376e+10, false},
+		{"lustre_osp_available_kilobytes", "Number of kilobytes readily available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 4.7168357376e+10, false},
+		{"lustre_osp_available_kilobytes", "Number of kilobytes readily available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 4.7168357376e+10, false},
+		{"lustre_osp_available_kilobytes", "Number of kilobytes readily available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 3.1445554176e+10, false},
+		{"lustre_osp_available_kilobytes", "Number of kilobytes readily available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 3.1445554176e+10, false},
+		{"lustre_osp_available_kilobytes", "Number of kilobytes readily available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 3.1445554176e+10, false},
+		{"lustre_osp_blocksize_bytes", "Filesystem block size in bytes of the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 4096, false},
+		{"lustre_osp_blocksize_bytes", "Filesystem block size in bytes of the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 4096, false},
+		{"lustre_osp_blocksize_bytes", "Filesystem block size in bytes of the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 4096, false},
+		{"lustre_osp_blocksize_bytes", "Filesystem block size in bytes of the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 4096, false},
+		{"lustre_osp_blocksize_bytes", "Filesystem block size in bytes of the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 4096, false},
+		{"lustre_osp_blocksize_bytes", "Filesystem block size in bytes of the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 4096, false},
+		{"lustre_osp_blocksize_bytes", "Filesystem block size in bytes of the target", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 4096, false},
+		{"lustre_osp_capacity_kilobytes", "Capacity of the target in kilobytes as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 4.7168369664e+10, false},
+		{"lustre_osp_capacity_kilobytes", "Capacity of the target in kilobytes as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 4.7168408576e+10, false},
+		{"lustre_osp_capacity_kilobytes", "Capacity of the target in kilobytes as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 4.71684096e+10, false},
+		{"lustre_osp_capacity_kilobytes", "Capacity of the target in kilobytes as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 4.71684096e+10, false},
+		{"lustre_osp_capacity_kilobytes", "Capacity of the target in kilobytes as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 3.14456064e+10, false},
+		{"lustre_osp_capacity_kilobytes", "Capacity of the target in kilobytes as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 3.14456064e+10, false},
+		{"lustre_osp_capacity_kilobytes", "Capacity of the target in kilobytes as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 3.14456064e+10, false},
+		{"lustre_osp_free_kilobytes", "Number of kilobytes free on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 4.716706304e+10, false},
+		{"lustre_osp_free_kilobytes", "Number of kilobytes free on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 4.7168398336e+10, false},
+		{"lustre_osp_free_kilobytes", "Number of kilobytes free on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 4.7168398336e+10, false},
+		{"lustre_osp_free_kilobytes", "Number of kilobytes free on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 4.7168398336e+10, false},
+		{"lustre_osp_free_kilobytes", "Number of kilobytes free on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 3.1445595136e+10, false},
+		{"lustre_osp_free_kilobytes", "Number of kilobytes free on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 3.1445595136e+10, false},
+		{"lustre_osp_free_kilobytes", "Number of kilobytes free on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 3.1445595136e+10, false},
+		{"lustre_osp_inodes_free", "The number of inodes (objects) available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1.55097993e+08, false},
+		{"lustre_osp_inodes_free", "The number of inodes (objects) available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1.474012448e+09, false},
+		{"lustre_osp_inodes_free", "The number of inodes (objects) available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1.474012448e+09, false},
+		{"lustre_osp_inodes_free", "The number of inodes (objects) available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1.474012448e+09, false},
+		{"lustre_osp_inodes_free", "The number of inodes (objects) available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 9.82674848e+08, false},
+		{"lustre_osp_inodes_free", "The number of inodes (objects) available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 9.82674848e+08, false},
+		{"lustre_osp_inodes_free", "The number of inodes (objects) available on the target as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 9.82674848e+08, false},
+		{"lustre_osp_inodes_maximum", "The maximum number of inodes (objects) the target can hold as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1.55098249e+08, false},
+		{"lustre_osp_inodes_maximum", "The maximum number of inodes (objects) the target can hold as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1.474012704e+09, false},
+		{"lustre_osp_inodes_maximum", "The maximum number of inodes (objects) the target can hold as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1.474012704e+09, false},
+		{"lustre_osp_inodes_maximum", "The maximum number of inodes (objects) the target can hold as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1.474012704e+09, false},
+		{"lustre_osp_inodes_maximum", "The maximum number of inodes (objects) the target can hold as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 9.82675104e+08, false},
+		{"lustre_osp_inodes_maximum", "The maximum number of inodes (objects) the target can hold as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 9.82675104e+08, false},
+		{"lustre_osp_inodes_maximum", "The maximum number of inodes (objects) the target can hold as seen by the MDT", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 9.82675104e+08, false},
+		{"lustre_lod_active_targets", "Number of active OSTs in the LOD", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 7, false},
+		{"lustre_lod_default_stripe_count", "Default number of stripes of new files", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lod_default_stripe_offset", "Default index of the first OST of new files, '-1' lets the allocator choose", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, -1, false},
+		{"lustre_lod_default_stripe_size_bytes", "Default stripe size in bytes of new files", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1.048576e+06, false},
+		{"lustre_lod_qos_maximum_age_seconds", "Maximum age in seconds of the statfs data used by the QoS allocator", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 5, false},
+		{"lustre_lod_qos_priority_free_percent", "Weight in percent given to free space by the QoS allocator", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 91, false},
+		{"lustre_lod_qos_threshold_rr_percent", "Free space imbalance in percent between OSTs above which the QoS allocator replaces the round-robin allocator", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 17, false},
+		{"lustre_lod_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOD, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0000"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lod_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOD, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0001"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lod_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOD, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0002"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lod_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOD, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0003"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lod_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOD, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0004"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lod_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOD, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0005"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lod_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOD, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"ost", "lustrefs-OST0006"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 1, false},
+		{"lustre_lod_targets", "Number of OSTs in the LOD", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000-mdtlov"}}, 7, false},
+		{"lustre_ost_pool_available_kilobytes", "Number of kilobytes readily available on the OSTs of the pool", gauge, []labelPair{{"component", "mdt"}, {"fsname", "lustrefs"}, {"pool", "flash"}}, 9.419352064e+10, false},
+		{"lustre_ost_pool_capacity_kilobytes", "Capacity of the OSTs of the pool in kilobytes", gauge, []labelPair{{"component", "mdt"}, {"fsname", "lustrefs"}, {"pool", "flash"}}, 9.4336777216e+10, false},
+		{"lustre_ost_pool_free_kilobytes", "Number of kilobytes free on the OSTs of the pool", gauge, []labelPair{{"component", "mdt"}, {"fsname", "lustrefs"}, {"pool", "flash"}}, 9.4197838848e+10, false},
+		{"lustre_ost_pool_member", "Returns '1' if the OST given in the 'ost' label is a member of the pool", gauge, []labelPair{{"component", "mdt"}, {"fsname", "lustrefs"}, {"ost", "lustrefs-OST0000"}, {"pool", "flash"}}, 1, false},
+		{"lustre_ost_pool_member", "Returns '1' if the OST given in the 'ost' label is a member of the pool", gauge, []labelPair{{"component", "mdt"}, {"fsname", "lustrefs"}, {"ost", "lustrefs-OST0002"}, {"pool", "flash"}}, 1, false},
+		{"lustre_client_ldlm_stats_total", "Number of LDLM lock operations requested by the client.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "bl_callback"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_client_ldlm_stats_total", "Number of LDLM lock operations requested by the client.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "cancel"}, {"target", "lustrefs-MDT0000"}}, 14, false},
+		{"lustre_client_ldlm_stats_total", "Number of LDLM lock operations requested by the client.", counter, []labelPair{{"client", "172.20.20.4"}, {"component", "mdt"}, {"operation", "enqueue"}, {"target", "lustrefs-MDT0000"}}, 28, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the action list of the coordinator per action type and status.", gauge, []labelPair{{"action", "ARCHIVE"}, {"component", "mdt"}, {"status", "STARTED"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the action list of the coordinator per action type and status.", gauge, []labelPair{{"action", "ARCHIVE"}, {"component", "mdt"}, {"status", "WAITING"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_actions", "Number of HSM actions in the action list of the coordinator per action type and status.", gauge, []labelPair{{"action", "RESTORE"}, {"component", "mdt"}, {"status", "SUCCEED"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_active_request_timeout_seconds", "Time in seconds after which active HSM requests time out", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 3600, false},
+		{"lustre_hsm_active_requests", "Number of HSM requests being handled by the copytools.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_agent_current_requests", "Number of HSM requests currently handled by the copytool agent.", gauge, []labelPair{{"archive_id", "1,2"}, {"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"uuid", "8c8d6b1a-5d35-6c5f-0f2b-6d4f1e9c2a11"}}, 1, false},
+		{"lustre_hsm_agent_requests_total", "Total number of HSM requests handled by the copytool agent per result.", counter, []labelPair{{"archive_id", "1,2"}, {"component", "mdt"}, {"result", "error"}, {"target", "lustrefs-MDT0000"}, {"uuid", "8c8d6b1a-5d35-6c5f-0f2b-6d4f1e9c2a11"}}, 2, false},
+		{"lustre_hsm_agent_requests_total", "Total number of HSM requests handled by the copytool agent per result.", counter, []labelPair{{"archive_id", "1,2"}, {"component", "mdt"}, {"result", "ok"}, {"target", "lustrefs-MDT0000"}, {"uuid", "8c8d6b1a-5d35-6c5f-0f2b-6d4f1e9c2a11"}}, 27, false},
+		{"lustre_hsm_agents", "Number of copytool agents registered to the coordinator.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_coordinator_state", "Returns '1' if the HSM coordinator is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "disabled"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_coordinator_state", "Returns '1' if the HSM coordinator is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "enabled"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_coordinator_state", "Returns '1' if the HSM coordinator is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "init"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_coordinator_state", "Returns '1' if the HSM coordinator is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "stopped"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_coordinator_state", "Returns '1' if the HSM coordinator is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"state", "stopping"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hsm_grace_delay_seconds", "Time in seconds after which finished HSM actions are removed from the action list", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 60, false},
+		{"lustre_hsm_loop_period_seconds", "Time in seconds between two runs of the HSM coordinator", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 10, false},
+		{"lustre_hsm_max_requests", "Maximum number of HSM requests handled by the copytools at the same time", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 3, false},
+		{"lustre_hsm_policy_enabled", "Returns '1' if the HSM coordinator policy is enabled, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"policy", "NoRetryAction"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_hsm_policy_enabled", "Returns '1' if the HSM coordinator policy is enabled, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"policy", "NonBlockingRestore"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "COMPLETE"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "INACTIVE"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "RECOVERING"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_recovery_status", "Returns '1' if the recovery of the target is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "WAITING"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "1"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "1"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "2"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_average_speed_objects_per_second", "Average number of objects per second checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "2"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "1"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "1"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "2"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_checked_objects", "Number of objects checked by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "2"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "1"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "1"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "2"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_failed_objects", "Number of objects the current or last run of the LFSCK component failed to check per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "2"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "bad_file_type"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "dangling"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "dangling"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "dirent"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "inconsistent_owner"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "linkea"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "lost_dirent"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "multiple_linked"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "multiple_referenced"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "multiple_referenced"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "name_hash"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "nlinks"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "orphan"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "others"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "striped_dirs"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "striped_shards"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "unmatched_pair"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_repaired", "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency.", gauge, []labelPair{{"component", "mdt"}, {"inconsistency", "unmatched_pairs"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "1"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "1"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "2"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_run_time_seconds", "Time in seconds spent by the current or last run of the LFSCK component per phase.", gauge, []labelPair{{"component", "mdt"}, {"phase", "2"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "co-failed"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "co-failed"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "co-paused"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "co-paused"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "co-stopped"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "co-stopped"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "completed"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "completed"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "crashed"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "crashed"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "init"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 1, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "init"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 1, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "partial"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "partial"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "paused"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "paused"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "scanning-phase1"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "scanning-phase1"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "scanning-phase2"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "scanning-phase2"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "stopped"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_status", "Returns '1' if the LFSCK component is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "stopped"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of successfully completed runs of the LFSCK component.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"type", "layout"}}, 0, false},
+		{"lustre_lfsck_success_total", "Total number of successfully completed runs of the LFSCK component.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}, {"type", "namespace"}}, 0, false},
+		{"lustre_oi_scrub_average_speed_objects_per_second", "Average number of objects per second checked by the current or last OI scrub run.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 32, false},
+		{"lustre_oi_scrub_checked_objects", "Number of objects checked by the current or last OI scrub run.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 259, false},
+		{"lustre_oi_scrub_failed_objects", "Number of objects the current or last OI scrub run failed to check.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_oi_scrub_run_time_seconds", "Time in seconds spent by the current or last OI scrub run.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 8, false},
+		{"lustre_oi_scrub_status", "Returns '1' if the OI scrub is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "completed"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_oi_scrub_status", "Returns '1' if the OI scrub is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "crashed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_oi_scrub_status", "Returns '1' if the OI scrub is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "failed"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_oi_scrub_status", "Returns '1' if the OI scrub is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "init"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_oi_scrub_status", "Returns '1' if the OI scrub is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "paused"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_oi_scrub_status", "Returns '1' if the OI scrub is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "scanning"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_oi_scrub_status", "Returns '1' if the OI scrub is in the given status, '0' otherwise", gauge, []labelPair{{"component", "mdt"}, {"status", "stopped"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_oi_scrub_success_total", "Total number of successfully completed OI scrub runs.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_oi_scrub_time_since_last_completed_seconds", "Time in seconds since the last OI scrub run completed.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2418, false},
+		{"lustre_oi_scrub_updated_objects", "Number of OI entries updated by the current or last OI scrub run.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_rename_directory_size_bytes", "Histogram of the size in bytes of the directories renames have been performed in per kind. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "mdt"}, {"kind", "crossdir_src"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_rename_directory_size_bytes", "Histogram of the size in bytes of the directories renames have been performed in per kind. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "mdt"}, {"kind", "crossdir_tgt"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_rename_directory_size_bytes", "Histogram of the size in bytes of the directories renames have been performed in per kind. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "mdt"}, {"kind", "samedir"}, {"target", "lustrefs-MDT0000"}}, 14, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "28"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "28"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "28"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "28"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "28"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "28"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "28"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "7"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "7"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "7"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "7"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "7"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "7"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"portal", "7"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "28"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "28"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "28"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "28"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "28"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "28"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "28"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "7"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "7"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "7"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "7"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "7"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "7"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"portal", "7"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "current"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mdt"}, {"estimate", "worst"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "NID_HASH"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "NID_STATS"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "UUID_HASH"}, {"target", "lustrefs-MDT0000"}}, 128, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets the hash table can grow to, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "NID_HASH"}, {"target", "lustrefs-MDT0000"}}, 4096, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets the hash table can grow to, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "NID_STATS"}, {"target", "lustrefs-MDT0000"}}, 4096, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets the hash table can grow to, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "UUID_HASH"}, {"target", "lustrefs-MDT0000"}}, 4096, false},
+		{"lustre_hash_items", "Number of items in the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "NID_HASH"}, {"target", "lustrefs-MDT0000"}}, 9, false},
+		{"lustre_hash_items", "Number of items in the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "NID_STATS"}, {"target", "lustrefs-MDT0000"}}, 4, false},
+		{"lustre_hash_items", "Number of items in the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "UUID_HASH"}, {"target", "lustrefs-MDT0000"}}, 9, false},
+		{"lustre_hash_load_factor", "Average number of items per bucket of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "NID_HASH"}, {"target", "lustrefs-MDT0000"}}, 0.07, false},
+		{"lustre_hash_load_factor", "Average number of items per bucket of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "NID_STATS"}, {"target", "lustrefs-MDT0000"}}, 0.031, false},
+		{"lustre_hash_load_factor", "Average number of items per bucket of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mdt"}, {"hash", "UUID_HASH"}, {"target", "lustrefs-MDT0000"}}, 0.07, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table has been resized, the hash table is given in the 'hash' label", counter, []labelPair{{"component", "mdt"}, {"hash", "NID_HASH"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table has been resized, the hash table is given in the 'hash' label", counter, []labelPair{{"component", "mdt"}, {"hash", "NID_STATS"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table has been resized, the hash table is given in the 'hash' label", counter, []labelPair{{"component", "mdt"}, {"hash", "UUID_HASH"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_site_busy_objects", "Number of objects in the object cache of the target being in use.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 91, false},
+		{"lustre_site_cache_death_races_total", "Total number of lookups of the object cache of the target finding an object being freed.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_site_cache_hits_total", "Total number of lookups of the object cache of the target finding the object.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 167, false},
+		{"lustre_site_cache_misses_total", "Total number of lookups of the object cache of the target not finding the object.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 125, false},
+		{"lustre_site_cache_races_total", "Total number of objects created concurrently in the object cache of the target.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_site_hash_buckets", "Number of hash buckets of the object cache of the target.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 4096, false},
+		{"lustre_site_hash_buckets_used", "Number of hash buckets of the object cache of the target holding objects.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 88, false},
+		{"lustre_site_hash_maximum_search_depth", "Maximum number of objects searched in a hash bucket of the object cache of the target.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_site_lru_purged_total", "Total number of objects purged from the LRU of the object cache of the target.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_site_objects", "Number of objects in the object cache of the target.", gauge, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 99, false},
+		{"lustre_site_objects_created_total", "Total number of objects created in the object cache of the target.", counter, []labelPair{{"component", "mdt"}, {"target", "lustrefs-MDT0000"}}, 128, false},
 
 		// MGS Metrics
 		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"target", "osd"}, {"component", "mgs"}}, 1.12074688e+09, false},
@@ -1490,47 +2180,293 @@ func TestCollector(t *testing.T) {
 		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "mgs"}, {"target", "osd"}}, 2.31003975e+08, false},
 		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "mgs"}, {"target", "osd"}}, 2.31004127e+08, false},
 		{"lustre_free_kilobytes", "Number of kilobytes free in the pool", gauge, []labelPair{{"component", "mgs"}, {"target", "osd"}}, 1.120748928e+09, false},
+		{"lustre_exports_total", "Total number of times the pool has been exported", counter, []labelPair{{"component", "mgs"}, {"target", "MGS"}}, 6, false},
+		{"lustre_mgs_filesystems", "Number of filesystems registered with the MGS.", gauge, []labelPair{{"component", "mgs"}, {"target", "MGS"}}, 1, false},
+		{"lustre_mgs_ir_nidtbl_version", "Version of the NID table of the filesystem sent to the clients for imperative recovery.", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"target", "MGS"}}, 24, false},
+		{"lustre_mgs_ir_nonir_clients", "Number of clients of the filesystem not supporting imperative recovery.", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_mgs_ir_notifications_total", "Total number of imperative recovery notifications sent to the clients of the filesystem.", counter, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"target", "MGS"}}, 14, false},
+		{"lustre_mgs_ir_notify_duration_maximum_seconds", "Maximum time in seconds spent notifying the clients of the filesystem for imperative recovery.", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"target", "MGS"}}, 0.00085374, false},
+		{"lustre_mgs_ir_notify_duration_seconds_total", "Total time in seconds spent notifying the clients of the filesystem for imperative recovery.", counter, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"target", "MGS"}}, 0.006756186, false},
+		{"lustre_mgs_ir_state", "Returns '1' if the imperative recovery of the filesystem is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"state", "disabled"}, {"target", "MGS"}}, 0, false},
+		{"lustre_mgs_ir_state", "Returns '1' if the imperative recovery of the filesystem is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"state", "full"}, {"target", "MGS"}}, 1, false},
+		{"lustre_mgs_ir_state", "Returns '1' if the imperative recovery of the filesystem is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"state", "partial"}, {"target", "MGS"}}, 0, false},
+		{"lustre_mgs_ir_state", "Returns '1' if the imperative recovery of the filesystem is in the given state, '0' otherwise", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"state", "startup"}, {"target", "MGS"}}, 0, false},
+		{"lustre_mgs_ir_timeout_seconds", "Time in seconds after which the MGS notifies the clients for imperative recovery of a restarted target", gauge, []labelPair{{"component", "mgs"}, {"target", "MGS"}}, 400, false},
+		{"lustre_mgs_targets", "Number of targets of the filesystem registered with the MGS per target type.", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"target", "MGS"}, {"target_type", "mdt"}}, 1, false},
+		{"lustre_mgs_targets", "Number of targets of the filesystem registered with the MGS per target type.", gauge, []labelPair{{"component", "mgs"}, {"fsname", "lustrefs"}, {"target", "MGS"}, {"target_type", "ost"}}, 7, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mgs"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mgs"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mgs"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mgs"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mgs"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mgs"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mgs"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mgs"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mgs"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mgs"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mgs"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mgs"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mgs"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mgs"}}, 0, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 1, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 29115, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "llog_origin_handle_next_block"}, {"service", "mgs"}}, 3775, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "llog_origin_handle_open"}, {"service", "mgs"}}, 2452, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "llog_origin_handle_read_header"}, {"service", "mgs"}}, 3656, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "mgs_config_read"}, {"service", "mgs"}}, 4131, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "mgs_connect"}, {"service", "mgs"}}, 698, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "mgs_disconnect"}, {"service", "mgs"}}, 67, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "mgs_target_reg"}, {"service", "mgs"}}, 1.388064e+06, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "obd_ping"}, {"service", "mgs"}}, 920030, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "ldlm_plain_enqueue"}, {"service", "mgs"}}, 69, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "llog_origin_handle_next_block"}, {"service", "mgs"}}, 46, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "llog_origin_handle_open"}, {"service", "mgs"}}, 49, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "llog_origin_handle_read_header"}, {"service", "mgs"}}, 44, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "mgs_config_read"}, {"service", "mgs"}}, 18, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "mgs_connect"}, {"service", "mgs"}}, 6, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "mgs_disconnect"}, {"service", "mgs"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "mgs_target_reg"}, {"service", "mgs"}}, 28, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mgs"}, {"operation", "obd_ping"}, {"service", "mgs"}}, 28854, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 63, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 0, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 0, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 10, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 2884, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 1.923115e+06, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 29115, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 32, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 3, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "mgs"}, {"service", "mgs"}}, 3, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mgs"}, {"estimate", "current"}, {"service", "mgs"}}, 1, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mgs"}, {"estimate", "worst"}, {"service", "mgs"}}, 1, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "NID_HASH"}, {"target", "MGS"}}, 128, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "NID_STATS"}, {"target", "MGS"}}, 128, false},
+		{"lustre_hash_buckets", "Current number of buckets of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "UUID_HASH"}, {"target", "MGS"}}, 128, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets the hash table can grow to, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "NID_HASH"}, {"target", "MGS"}}, 4096, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets the hash table can grow to, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "NID_STATS"}, {"target", "MGS"}}, 4096, false},
+		{"lustre_hash_buckets_maximum", "Maximum number of buckets the hash table can grow to, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "UUID_HASH"}, {"target", "MGS"}}, 4096, false},
+		{"lustre_hash_items", "Number of items in the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "NID_HASH"}, {"target", "MGS"}}, 5, false},
+		{"lustre_hash_items", "Number of items in the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "NID_STATS"}, {"target", "MGS"}}, 5, false},
+		{"lustre_hash_items", "Number of items in the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "UUID_HASH"}, {"target", "MGS"}}, 5, false},
+		{"lustre_hash_load_factor", "Average number of items per bucket of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "NID_HASH"}, {"target", "MGS"}}, 0.039, false},
+		{"lustre_hash_load_factor", "Average number of items per bucket of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "NID_STATS"}, {"target", "MGS"}}, 0.039, false},
+		{"lustre_hash_load_factor", "Average number of items per bucket of the hash table, the hash table is given in the 'hash' label", gauge, []labelPair{{"component", "mgs"}, {"hash", "UUID_HASH"}, {"target", "MGS"}}, 0.039, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table has been resized, the hash table is given in the 'hash' label", counter, []labelPair{{"component", "mgs"}, {"hash", "NID_HASH"}, {"target", "MGS"}}, 0, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table has been resized, the hash table is given in the 'hash' label", counter, []labelPair{{"component", "mgs"}, {"hash", "NID_STATS"}, {"target", "MGS"}}, 0, false},
+		{"lustre_hash_rehashes_total", "Total number of times the hash table has been resized, the hash table is given in the 'hash' label", counter, []labelPair{{"component", "mgs"}, {"hash", "UUID_HASH"}, {"target", "MGS"}}, 0, false},
+
+		// MDS Metrics
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 7, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 1, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 1, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 1, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 119847, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 10, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 13, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 1, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "fld_read"}, {"service", "mdt_fld"}}, 1733, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_close"}, {"service", "mdt_readpage"}}, 4955, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_connect"}, {"service", "mdt"}}, 133751, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_disconnect"}, {"service", "mdt"}}, 509, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_get_root"}, {"service", "mdt"}}, 13, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_getattr"}, {"service", "mdt"}}, 37, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_hsm_state_set"}, {"service", "mdt"}}, 1255, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_readpage"}, {"service", "mdt_readpage"}}, 1929, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_statfs"}, {"service", "mdt"}}, 21, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "obd_ping"}, {"service", "mdt"}}, 2.428494e+06, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "seq_query"}, {"service", "mdt_seqm"}}, 121063, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "fld_read"}, {"service", "mdt_fld"}}, 10, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "ldlm_ibits_enqueue"}, {"service", "mdt"}}, 28, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_close"}, {"service", "mdt_readpage"}}, 9, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_connect"}, {"service", "mdt"}}, 15, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_disconnect"}, {"service", "mdt"}}, 5, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_get_root"}, {"service", "mdt"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_getattr"}, {"service", "mdt"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_hsm_state_set"}, {"service", "mdt"}}, 13, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_readpage"}, {"service", "mdt_readpage"}}, 4, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_reint_open"}, {"service", "mdt"}}, 10, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_reint_setattr"}, {"service", "mdt"}}, 57, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "mds_statfs"}, {"service", "mdt"}}, 1, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "obd_ping"}, {"service", "mdt"}}, 57146, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "mds"}, {"operation", "seq_query"}, {"service", "mdt_seqm"}}, 1, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 63, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 64, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 63, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 64, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 3, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 234, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 10, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 10, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 10, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 10, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 6033, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 84, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 84, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 73, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 4.709981e+06, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 744, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 762, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 73, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 57267, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 10, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 13, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 1, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 248, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 256, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}}, 248, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 120, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 256, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}}, 256, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}}, 120, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 6, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 2, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}}, 4, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 4, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 2, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}}, 2, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt"}}, 17, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_fld"}}, 2, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_out"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_readpage"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqm"}}, 2, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_seqs"}}, 2, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "mds"}, {"service", "mdt_setattr"}}, 4, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "mdt"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_out"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_fld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_out"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_readpage"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqm"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_seqs"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "mds"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "mdt_setattr"}}, 0, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "current"}, {"service", "mdt"}}, 1, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "current"}, {"service", "mdt_fld"}}, 1, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "current"}, {"service", "mdt_out"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "current"}, {"service", "mdt_readpage"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "current"}, {"service", "mdt_seqm"}}, 1, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "current"}, {"service", "mdt_seqs"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "current"}, {"service", "mdt_setattr"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "worst"}, {"service", "mdt"}}, 1, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "worst"}, {"service", "mdt_fld"}}, 1, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "worst"}, {"service", "mdt_out"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "worst"}, {"service", "mdt_readpage"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "worst"}, {"service", "mdt_seqm"}}, 1, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "worst"}, {"service", "mdt_seqs"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "mds"}, {"estimate", "worst"}, {"service", "mdt_setattr"}}, 10, false},
 
 		// Client Metrics
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1537, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1.638901e+07, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 117183, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 11916, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1489, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 458648, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 24502, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 2876, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1.145365e+06, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 51901, false},
-		{"lustre_pages_per_rpc_total", "Total number of pages per RPC.", counter, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 5860, false},
-		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.30405497e+08, false},
 		{"lustre_xattr_cache_enabled", "Returns '1' if extended attribute cache is enabled", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
 		{"lustre_read_bytes_total", "The total number of bytes that have been read.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.194304e+06, false},
 		{"lustre_write_samples_total", "Total number of writes that have been recorded.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 8.946781e+07, false},
-		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.8300029952e+11, false},
 		{"lustre_maximum_read_ahead_megabytes", "Maximum number of megabytes to read ahead", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 64, false},
 		{"lustre_statahead_agl_enabled", "Returns '1' if the Asynchronous Glimpse Lock (AGL) for statahead is enabled", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
 		{"lustre_checksum_pages_enabled", "Returns '1' if data checksumming is enabled for the client", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
@@ -1539,82 +2475,8 @@ func TestCollector(t *testing.T) {
 		{"lustre_read_minimum_size_bytes", "The minimum read size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.194304e+06, false},
 		{"lustre_lazystatfs_enabled", "Returns '1' if lazystatfs (a non-blocking alternative to statfs) is enabled for the client", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
 		{"lustre_read_samples_total", "Total number of reads that have been recorded.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1, false},
-		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1.048576e+06, false},
 		{"lustre_maximum_ea_size_bytes", "Maximum Extended Attribute (EA) size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 216, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1048576"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "131072"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "134217728"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16384"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "16777216"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2048"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2097152"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "262144"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "32768"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "33554432"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4096"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4194304"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "524288"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "65536"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "67108864"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8192"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8388608"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 64, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1024"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 173, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1048576"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 125471, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "128"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 8, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "131072"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 22293, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "134217728"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 8.127518e+06, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 2, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16384"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 2566, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "16777216"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1.598283e+06, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2048"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 336, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2097152"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 241574, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "256"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 35, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "262144"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 45678, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 5, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "32768"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 5268, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "33554432"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 2.274739e+06, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4096"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 669, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4194304"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 476715, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "512"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 49, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "524288"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 64199, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "64"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 8, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "65536"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 10796, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "67108864"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 4.270417e+06, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 0, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8192"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 1319, false},
-		{"lustre_rpcs_offset", "Current RPC offset by size.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8388608"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}}, 942102, false},
 		{"lustre_write_bytes_total", "The total number of bytes that have been written.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 9.381379729408e+13, false},
-		{"lustre_free_kilobytes", "Number of kilobytes free in the pool", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.83007085568e+11, false},
-		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.30405267e+08, false},
-		{"lustre_capacity_kilobytes", "Capacity of the pool in kilobytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.83010362368e+11, false},
 		{"lustre_default_ea_size_bytes", "Default Extended Attribute (EA) size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 128, false},
 		{"lustre_maximum_read_ahead_whole_megabytes", "Maximum file size in megabytes for a file to be read in its entirety", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2, false},
 		{"lustre_maximum_read_ahead_per_file_megabytes", "Maximum number of megabytes per file to read ahead", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 64, false},
@@ -1629,58 +2491,453 @@ func TestCollector(t *testing.T) {
 		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "removexattr"}, {"target", "lustrefs-ffff88105db50000"}}, 134, false},
 		{"lustre_stats_total", "Number of operations the filesystem has performed.", counter, []labelPair{{"component", "client"}, {"operation", "truncate"}, {"target", "lustrefs-ffff88105db50000"}}, 134, false},
 		{"lustre_write_maximum_size_bytes", "The maximum write size in bytes.", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1.048576e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 90, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "10"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "11"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "12"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "13"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "14"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "15"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 13, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 12, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 11, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 9, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "5"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 8, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 53, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "read"}, {"size", "9"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0001-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0002-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0003-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0004-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0005-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "0"}, {"target", "lustrefs-OST0006-osc-ffff88105db50000"}, {"type", "osc"}}, 0, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "1"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 1.053804e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "10"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 91419, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "11"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 18683, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "12"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 2399, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "13"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 174, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "14"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 5, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "15"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 2, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "2"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 3.518152e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "3"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 4.520192e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "4"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 3.656921e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "5"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 2.35005e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "6"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 1.396192e+06, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "7"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 832325, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "8"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 497409, false},
-		{"lustre_rpcs_in_flight", "Current number of RPCs that are processing during the snapshot.", gauge, []labelPair{{"component", "client"}, {"operation", "write"}, {"size", "9"}, {"target", "lustrefs-OST0000-osc-ffff88105db50000"}, {"type", "osc"}}, 272560, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"operation", "modify"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"operation", "modify"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}, {"type", "mdc"}}, 196, false},
+		{"lustre_statahead_agl_total", "Total number of Asynchronous Glimpse Lock (AGL) instances started.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 0, false},
+		{"lustre_statahead_total", "Total number of statahead instances started.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 0, false},
+		{"lustre_statahead_wrong_total", "Total number of statahead instances stopped because of a non-sequential directory traversal.", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 0, false},
+		{"lustre_cached_busy_pages", "Number of busy pages in the page cache of the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 5748, false},
+		{"lustre_cached_busy_pages", "Number of busy pages in the page cache of the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_cached_busy_pages", "Number of busy pages in the page cache of the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_cached_busy_pages", "Number of busy pages in the page cache of the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_cached_busy_pages", "Number of busy pages in the page cache of the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_cached_busy_pages", "Number of busy pages in the page cache of the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_cached_busy_pages", "Number of busy pages in the page cache of the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_cached_megabytes", "Size of the page cache in megabytes used by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 5085, false},
+		{"lustre_cached_megabytes", "Size of the page cache in megabytes used by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_cached_megabytes", "Size of the page cache in megabytes used by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_cached_megabytes", "Size of the page cache in megabytes used by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_cached_megabytes", "Size of the page cache in megabytes used by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_cached_megabytes", "Size of the page cache in megabytes used by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_cached_megabytes", "Size of the page cache in megabytes used by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_cached_reclaim_pages_total", "Total number of pages reclaimed from the page cache of the client for this OST", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 5397, false},
+		{"lustre_cached_reclaim_pages_total", "Total number of pages reclaimed from the page cache of the client for this OST", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_cached_reclaim_pages_total", "Total number of pages reclaimed from the page cache of the client for this OST", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_cached_reclaim_pages_total", "Total number of pages reclaimed from the page cache of the client for this OST", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_cached_reclaim_pages_total", "Total number of pages reclaimed from the page cache of the client for this OST", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_cached_reclaim_pages_total", "Total number of pages reclaimed from the page cache of the client for this OST", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_cached_reclaim_pages_total", "Total number of pages reclaimed from the page cache of the client for this OST", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_checksum_type_info", "Checksum algorithm used by the client for this OST, the selected algorithm is given in the 'algorithm' label", gauge, []labelPair{{"algorithm", "crc32c"}, {"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_checksum_type_info", "Checksum algorithm used by the client for this OST, the selected algorithm is given in the 'algorithm' label", gauge, []labelPair{{"algorithm", "crc32c"}, {"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_checksum_type_info", "Checksum algorithm used by the client for this OST, the selected algorithm is given in the 'algorithm' label", gauge, []labelPair{{"algorithm", "crc32c"}, {"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_checksum_type_info", "Checksum algorithm used by the client for this OST, the selected algorithm is given in the 'algorithm' label", gauge, []labelPair{{"algorithm", "crc32c"}, {"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_checksum_type_info", "Checksum algorithm used by the client for this OST, the selected algorithm is given in the 'algorithm' label", gauge, []labelPair{{"algorithm", "crc32c"}, {"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_checksum_type_info", "Checksum algorithm used by the client for this OST, the selected algorithm is given in the 'algorithm' label", gauge, []labelPair{{"algorithm", "crc32c"}, {"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_checksum_type_info", "Checksum algorithm used by the client for this OST, the selected algorithm is given in the 'algorithm' label", gauge, []labelPair{{"algorithm", "crc32c"}, {"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_checksums_enabled", "Returns '1' if data checksumming is enabled for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_checksums_enabled", "Returns '1' if data checksumming is enabled for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_checksums_enabled", "Returns '1' if data checksumming is enabled for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_checksums_enabled", "Returns '1' if data checksumming is enabled for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_checksums_enabled", "Returns '1' if data checksumming is enabled for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_checksums_enabled", "Returns '1' if data checksumming is enabled for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_checksums_enabled", "Returns '1' if data checksumming is enabled for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_dirty_bytes", "Number of bytes of dirty data held by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 2.7815936e+07, false},
+		{"lustre_dirty_bytes", "Number of bytes of dirty data held by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_dirty_bytes", "Number of bytes of dirty data held by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_dirty_bytes", "Number of bytes of dirty data held by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_dirty_bytes", "Number of bytes of dirty data held by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_dirty_bytes", "Number of bytes of dirty data held by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_dirty_bytes", "Number of bytes of dirty data held by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_dirty_grant_bytes", "Number of bytes of dirty data covered by the grant of this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 6.9074944e+07, false},
+		{"lustre_dirty_grant_bytes", "Number of bytes of dirty data covered by the grant of this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_dirty_grant_bytes", "Number of bytes of dirty data covered by the grant of this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_dirty_grant_bytes", "Number of bytes of dirty data covered by the grant of this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_dirty_grant_bytes", "Number of bytes of dirty data covered by the grant of this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_dirty_grant_bytes", "Number of bytes of dirty data covered by the grant of this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_dirty_grant_bytes", "Number of bytes of dirty data covered by the grant of this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_grant_bytes", "Number of bytes of space granted by this OST to the client", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 262144, false},
+		{"lustre_grant_bytes", "Number of bytes of space granted by this OST to the client", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 9.699328e+06, false},
+		{"lustre_grant_bytes", "Number of bytes of space granted by this OST to the client", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 9.699328e+06, false},
+		{"lustre_grant_bytes", "Number of bytes of space granted by this OST to the client", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 9.699328e+06, false},
+		{"lustre_grant_bytes", "Number of bytes of space granted by this OST to the client", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 9.699328e+06, false},
+		{"lustre_grant_bytes", "Number of bytes of space granted by this OST to the client", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 9.699328e+06, false},
+		{"lustre_grant_bytes", "Number of bytes of space granted by this OST to the client", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 9.699328e+06, false},
+		{"lustre_lockless_read_bytes_total", "Total number of bytes read from this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lockless_read_bytes_total", "Total number of bytes read from this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_lockless_read_bytes_total", "Total number of bytes read from this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lockless_read_bytes_total", "Total number of bytes read from this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_lockless_read_bytes_total", "Total number of bytes read from this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lockless_read_bytes_total", "Total number of bytes read from this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_lockless_read_bytes_total", "Total number of bytes read from this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lockless_truncate_total", "Total number of truncates issued to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lockless_truncate_total", "Total number of truncates issued to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_lockless_truncate_total", "Total number of truncates issued to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lockless_truncate_total", "Total number of truncates issued to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_lockless_truncate_total", "Total number of truncates issued to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lockless_truncate_total", "Total number of truncates issued to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_lockless_truncate_total", "Total number of truncates issued to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lockless_write_bytes_total", "Total number of bytes written to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_lockless_write_bytes_total", "Total number of bytes written to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_lockless_write_bytes_total", "Total number of bytes written to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lockless_write_bytes_total", "Total number of bytes written to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_lockless_write_bytes_total", "Total number of bytes written to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lockless_write_bytes_total", "Total number of bytes written to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_lockless_write_bytes_total", "Total number of bytes written to this OST without taking a lock", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_lost_grant_bytes", "Number of bytes of grant lost by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 655360, false},
+		{"lustre_lost_grant_bytes", "Number of bytes of grant lost by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_lost_grant_bytes", "Number of bytes of grant lost by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_lost_grant_bytes", "Number of bytes of grant lost by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_lost_grant_bytes", "Number of bytes of grant lost by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_lost_grant_bytes", "Number of bytes of grant lost by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_lost_grant_bytes", "Number of bytes of grant lost by the client for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_maximum_dirty_megabytes", "Maximum amount of dirty data in megabytes the client may hold for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 32, false},
+		{"lustre_maximum_dirty_megabytes", "Maximum amount of dirty data in megabytes the client may hold for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 32, false},
+		{"lustre_maximum_dirty_megabytes", "Maximum amount of dirty data in megabytes the client may hold for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 32, false},
+		{"lustre_maximum_dirty_megabytes", "Maximum amount of dirty data in megabytes the client may hold for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 32, false},
+		{"lustre_maximum_dirty_megabytes", "Maximum amount of dirty data in megabytes the client may hold for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 32, false},
+		{"lustre_maximum_dirty_megabytes", "Maximum amount of dirty data in megabytes the client may hold for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 32, false},
+		{"lustre_maximum_dirty_megabytes", "Maximum amount of dirty data in megabytes the client may hold for this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 32, false},
+		{"lustre_maximum_pages_per_rpc", "Maximum number of pages per bulk RPC sent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 1024, false},
+		{"lustre_maximum_pages_per_rpc", "Maximum number of pages per bulk RPC sent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 1024, false},
+		{"lustre_maximum_pages_per_rpc", "Maximum number of pages per bulk RPC sent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 1024, false},
+		{"lustre_maximum_pages_per_rpc", "Maximum number of pages per bulk RPC sent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 1024, false},
+		{"lustre_maximum_pages_per_rpc", "Maximum number of pages per bulk RPC sent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 1024, false},
+		{"lustre_maximum_pages_per_rpc", "Maximum number of pages per bulk RPC sent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 1024, false},
+		{"lustre_maximum_pages_per_rpc", "Maximum number of pages per bulk RPC sent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 1024, false},
+		{"lustre_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 8, false},
+		{"lustre_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 8, false},
+		{"lustre_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 8, false},
+		{"lustre_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 8, false},
+		{"lustre_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 8, false},
+		{"lustre_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 8, false},
+		{"lustre_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 8, false},
+		{"lustre_resend_count", "Number of times a failed RPC is resent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 10, false},
+		{"lustre_resend_count", "Number of times a failed RPC is resent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 10, false},
+		{"lustre_resend_count", "Number of times a failed RPC is resent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 10, false},
+		{"lustre_resend_count", "Number of times a failed RPC is resent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 10, false},
+		{"lustre_resend_count", "Number of times a failed RPC is resent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 10, false},
+		{"lustre_resend_count", "Number of times a failed RPC is resent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 10, false},
+		{"lustre_resend_count", "Number of times a failed RPC is resent to this OST", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 10, false},
+		{"lustre_unstable_megabytes", "Size in megabytes of the data sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_unstable_megabytes", "Size in megabytes of the data sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_unstable_megabytes", "Size in megabytes of the data sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_unstable_megabytes", "Size in megabytes of the data sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_unstable_megabytes", "Size in megabytes of the data sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_unstable_megabytes", "Size in megabytes of the data sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_unstable_megabytes", "Size in megabytes of the data sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_unstable_pages", "Number of pages sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_unstable_pages", "Number of pages sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_unstable_pages", "Number of pages sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_unstable_pages", "Number of pages sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_unstable_pages", "Number of pages sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_unstable_pages", "Number of pages sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_unstable_pages", "Number of pages sent to this OST but not yet committed to stable storage", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 6, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_current_rpcs_in_flight", "Current number of RPCs in flight.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 1.8210287e+07, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_pages_per_rpc", "Histogram of pages per RPC. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 1244, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_pending_pages", "Current number of pages pending to be sent.", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 1.8210287e+07, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_in_flight", "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "read"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0000"}, {"type", "osc"}}, 1.8210287e+07, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0001"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0002"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0003"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0004"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0005"}, {"type", "osc"}}, 0, false},
+		{"lustre_rpcs_offset", "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0.", histogram, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"operation", "write"}, {"target", "lustrefs-OST0006"}, {"type", "osc"}}, 0, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 408, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "client"}, {"nid", "172.20.20.2@o2ib"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 2, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "network"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "services"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "CLOSED"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "CONNECTING"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "DISCONN"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "EVICTED"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "FULL"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "IDLE"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "NEW"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "RECOVER"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "REPLAY"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 6054, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 354, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 338, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 340, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 331, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 334, false},
+		{"lustre_import_average_wait_time_microseconds", "Average time in microseconds RPCs of the import waited for a reply", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 334, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_connection_attempts_total", "Total number of connection attempts of the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"nid", "172.20.20.5@o2ib"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_connection_info", "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"nid", "172.20.20.6@o2ib"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 2, false},
+		{"lustre_import_failover_nids", "Number of failover NIDs configured for the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 2, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_invalidations_total", "Total number of invalidations of the import, e.g. after an eviction by the target", counter, []labelPair{{"component", "client"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 0, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_rpc_timeouts_total", "Total number of RPC timeouts on the import", counter, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 8, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_rpcs_in_flight", "Number of RPCs in flight on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "network"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "network"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "network"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "network"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "network"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "network"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "network"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "services"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "services"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "services"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "services"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "services"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "services"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_service_estimate_seconds", "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "services"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CLOSED"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "CONNECTING"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "DISCONN"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "EVICTED"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "FULL"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "FULL"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "FULL"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "FULL"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "FULL"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "FULL"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "FULL"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "IDLE"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "IDLE"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "IDLE"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "IDLE"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "IDLE"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "IDLE"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "IDLE"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "NEW"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "NEW"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "NEW"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "NEW"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "NEW"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "NEW"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "NEW"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "RECOVER"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_LOCKS"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0001"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0003"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0005"}}, 0, false},
+		{"lustre_import_state", "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"state", "REPLAY_WAIT"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_import_write_bytes_per_rpc", "Average number of bytes written per bulk RPC on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 3.585782e+06, false},
+		{"lustre_import_write_megabytes_per_second", "Average write throughput of the import in megabytes per second", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 592.2, false},
+		{"lustre_import_write_microseconds_per_rpc", "Average time in microseconds spent per bulk write RPC on the import", gauge, []labelPair{{"component", "client"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 6055, false},
+		{"lustre_available_kilobytes", "Number of kilobytes readily available in the pool", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.8300029952e+11, false},
+		{"lustre_blocksize_bytes", "Filesystem block size in bytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 1.048576e+06, false},
+		{"lustre_capacity_kilobytes", "Capacity of the pool in kilobytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.83010362368e+11, false},
+		{"lustre_free_kilobytes", "Number of kilobytes free in the pool", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 2.83007085568e+11, false},
+		{"lustre_inodes_free", "The number of inodes (objects) available", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.30405267e+08, false},
+		{"lustre_inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-ffff88105db50000"}}, 4.30405497e+08, false},
+		{"lustre_lmv_active_targets", "Number of MDTs the mount considers active", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilmv-ffff88105db50000"}}, 1, false},
+		{"lustre_lmv_target_active", "Returns '1' if the MDT given in the 'mdt' label is active in the LMV of the mount, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"mdt", "lustrefs-MDT0000"}, {"target", "lustrefs-clilmv-ffff88105db50000"}}, 1, false},
+		{"lustre_lmv_targets", "Number of MDTs of the mount", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilmv-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_active_targets", "Number of OSTs the mount considers active", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 7, false},
+		{"lustre_lov_default_stripe_count", "Default number of stripes of new files", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_default_stripe_offset", "Default index of the first OST of new files, '-1' lets the allocator choose", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, -1, false},
+		{"lustre_lov_default_stripe_size_bytes", "Default stripe size in bytes of new files", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1.048576e+06, false},
+		{"lustre_lov_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOV of the mount, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0000"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOV of the mount, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0001"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOV of the mount, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0002"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOV of the mount, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0003"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOV of the mount, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0004"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOV of the mount, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0005"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_target_active", "Returns '1' if the OST given in the 'ost' label is active in the LOV of the mount, '0' otherwise", gauge, []labelPair{{"component", "client"}, {"ost", "lustrefs-OST0006"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1, false},
+		{"lustre_lov_targets", "Number of OSTs of the mount", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 7, false},
+		{"lustre_lov_available_kilobytes", "Number of kilobytes readily available on the OSTs of the mount", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 2.82993355776e+11, false},
+		{"lustre_lov_blocksize_bytes", "Filesystem block size in bytes of the OSTs of the mount", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 1.048576e+06, false},
+		{"lustre_lov_capacity_kilobytes", "Capacity of the OSTs of the mount in kilobytes", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 2.83010362368e+11, false},
+		{"lustre_lov_free_kilobytes", "Number of kilobytes free on the OSTs of the mount", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 2.83005468672e+11, false},
+		{"lustre_lov_inodes_free", "The number of inodes (objects) available on the OSTs of the mount", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 7.416119982e+09, false},
+		{"lustre_lov_inodes_maximum", "The maximum number of inodes (objects) the OSTs of the mount can hold", gauge, []labelPair{{"component", "client"}, {"target", "lustrefs-clilov-ffff88105db50000"}}, 7.416121774e+09, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "17"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "6"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"portal", "12"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"portal", "17"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"portal", "23"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"portal", "30"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "17"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "28"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "6"}, {"target", "lustrefs-OST0000"}}, 31, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"portal", "7"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"portal", "12"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"portal", "17"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"portal", "23"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_estimate_seconds", "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"portal", "30"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "current"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0001"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0003"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0005"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"mount", "lustrefs-ffff88105db50000"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_import_at_network_latency_seconds", "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "client"}, {"estimate", "worst"}, {"target", "lustrefs-MDT0000-mdc-ffff88105db50000"}}, 1, false},
 
 		// Generic Metrics
 		{"lustre_cache_miss_total", "Total number of cache misses.", counter, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
@@ -1697,7 +2954,342 @@ func TestCollector(t *testing.T) {
 		{"lustre_grows_failure_total", "Total number of failures while attempting to add pages.", counter, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
 		{"lustre_shrinks_total", "Total number of shrinks.", counter, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
 		{"lustre_free_page_low", "Lowest number of free pages reached.", gauge, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
-		{"lustre_out_of_memory_request_total", "Total number of out of memory requests.", 0, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
+		{"lustre_out_of_memory_request_total", "Total number of out of memory requests.", counter, []labelPair{{"component", "generic"}, {"target", "sptlrpc"}}, 0, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 32, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counter, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 51, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_locks", "Number of locks in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 3.9e+06, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 6400, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 6400, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 6400, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 5, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_resources", "Number of resources in the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 3.864795e+06, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 3.864795e+06, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 3.864795e+06, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 3.864795e+06, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 32065, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 961920, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 2, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_granted", "Number of granted locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 3.2064e+06, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_limit", "Maximum number of locks in the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 1, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0000"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0002"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0004"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "lwp"}, {"target", "lustrefs-MDT0000-lwp-OST0006"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mdt"}, {"target", "lustrefs-MDT0000"}}, 1.154304e+11, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgc"}, {"target", "MGC172.20.20.1@o2ib"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "mgs"}, {"target", "MGS"}}, 36000, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0000-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0001-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0002-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0003-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0004-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0005-osc-MDT0000"}}, 0, false},
+		{"lustre_ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gauge, []labelPair{{"component", "generic"}, {"namespace_type", "osp"}, {"target", "lustrefs-OST0006-osc-MDT0000"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_active_requests", "Number of requests being handled by the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_queued_requests", "Number of requests queued in the NRS policy of the service", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "crrn"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "delay"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "fifo"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 1, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "high_priority"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_nrs_policy_started", "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise", gauge, []labelPair{{"component", "generic"}, {"policy", "tbf"}, {"queue", "regular"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 1, false},
+		{"lustre_service_active_requests_maximum", "Maximum number of requests handled concurrently by the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 1, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 14, false},
+		{"lustre_service_active_requests_sum_total", "Sum of the number of active requests sampled when requests were handled by the service.", counter, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 10, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "generic"}, {"operation", "ldlm_bl_callback"}, {"service", "ldlm_cbd"}}, 299, false},
+		{"lustre_service_operation_time_microseconds_total", "Total time in microseconds spent handling requests of the service per operation.", counter, []labelPair{{"component", "generic"}, {"operation", "ldlm_cancel"}, {"service", "ldlm_canceld"}}, 490, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "generic"}, {"operation", "ldlm_bl_callback"}, {"service", "ldlm_cbd"}}, 10, false},
+		{"lustre_service_operations_total", "Total number of requests handled by the service per operation.", counter, []labelPair{{"component", "generic"}, {"operation", "ldlm_cancel"}, {"service", "ldlm_canceld"}}, 14, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 63, false},
+		{"lustre_service_request_buffers_available_minimum", "Minimum number of request buffers available to the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_service_request_history_length", "Number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_service_request_queue_depth_maximum", "Maximum request queue depth of the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 0, false},
+		{"lustre_service_request_queue_depth_sum_total", "Sum of the request queue depths sampled when requests arrived at the service.", counter, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 0, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 10, false},
+		{"lustre_service_request_timeout_maximum_seconds", "Maximum timeout in seconds given to requests of the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 10, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 83, false},
+		{"lustre_service_request_wait_time_maximum_microseconds", "Maximum time in microseconds a request waited before being handled by the service.", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 402, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 933, false},
+		{"lustre_service_request_wait_time_microseconds_total", "Total time in microseconds requests waited before being handled by the service.", counter, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 989, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 14, false},
+		{"lustre_service_requests_total", "Total number of requests handled by the service.", counter, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 10, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 128, false},
+		{"lustre_service_threads_maximum", "Maximum number of threads of the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 128, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 6, false},
+		{"lustre_service_threads_minimum", "Minimum number of threads of the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 4, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_canceld"}}, 6, false},
+		{"lustre_service_threads_started", "Number of threads started by the service", gauge, []labelPair{{"component", "generic"}, {"service", "ldlm_cbd"}}, 4, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "generic"}, {"estimate", "current"}, {"service", "ldlm_canceld"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "generic"}, {"estimate", "current"}, {"service", "ldlm_cbd"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "generic"}, {"estimate", "worst"}, {"service", "ldlm_canceld"}}, 10, false},
+		{"lustre_service_at_estimate_seconds", "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label", gauge, []labelPair{{"component", "generic"}, {"estimate", "worst"}, {"service", "ldlm_cbd"}}, 10, false},
+		{"lustre_at_early_margin_seconds", "Time in seconds before the adaptive timeout of a request expires at which an early reply is sent", gauge, []labelPair{{"component", "generic"}}, 5, false},
+		{"lustre_at_extra_seconds", "Time in seconds an early reply extends the adaptive timeout of a request by", gauge, []labelPair{{"component", "generic"}}, 30, false},
+		{"lustre_at_history_seconds", "Time in seconds the worst adaptive timeout estimate is remembered for", gauge, []labelPair{{"component", "generic"}}, 600, false},
+		{"lustre_at_maximum_seconds", "Maximum adaptive timeout in seconds, '0' if adaptive timeouts are disabled", gauge, []labelPair{{"component", "generic"}}, 600, false},
+		{"lustre_at_minimum_seconds", "Minimum adaptive timeout in seconds", gauge, []labelPair{{"component", "generic"}}, 0, false},
+		{"lustre_bulk_timeout_seconds", "Time in seconds to wait for a bulk transfer before it is considered failed", gauge, []labelPair{{"component", "generic"}}, 100, false},
+		{"lustre_ldlm_timeout_seconds", "Time in seconds a client is given to reply to a lock callback of the server, if adaptive timeouts are disabled", gauge, []labelPair{{"component", "generic"}}, 20, false},
+		{"lustre_timeout_seconds", "Time in seconds a client waits for a reply of the server, if adaptive timeouts are disabled", gauge, []labelPair{{"component", "generic"}}, 100, false},
 
 		// LNET Metrics
 		{"lustre_console_max_delay_centiseconds", "Minimum time in centiseconds before the console logs a message", gauge, []labelPair{{"component", "lnet"}, {"target", "lnet"}}, 60000, false},
@@ -1723,6 +3315,154 @@ func TestCollector(t *testing.T) {
 		{"lustre_fail_maximum", "Maximum number of times to fail", gauge, []labelPair{{"component", "lnet"}, {"target", "lnet"}}, 0, false},
 		{"lustre_panic_on_lbug_enabled", "Returns 1 if panic_on_lbug is enabled", gauge, []labelPair{{"component", "lnet"}, {"target", "lnet"}}, 1, false},
 
+		// Quota Metrics
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_quota_slave_accounting_enabled", "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_enforced", "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_global_index_uptodate", "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_local_index_uptodate", "Returns '1' if the slave quota index of the target is up to date, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_master_connected", "Returns '1' if the quota slave of the target is connected to the quota master, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"target", "lustrefs-MDT0000"}}, 1, false},
+		{"lustre_quota_slave_master_connected", "Returns '1' if the quota slave of the target is connected to the quota master, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"target", "lustrefs-OST0000"}}, 1, false},
+		{"lustre_quota_slave_master_connected", "Returns '1' if the quota slave of the target is connected to the quota master, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"target", "lustrefs-OST0002"}}, 1, false},
+		{"lustre_quota_slave_master_connected", "Returns '1' if the quota slave of the target is connected to the quota master, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"target", "lustrefs-OST0004"}}, 1, false},
+		{"lustre_quota_slave_master_connected", "Returns '1' if the quota slave of the target is connected to the quota master, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"target", "lustrefs-OST0006"}}, 1, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "group"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "project"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-MDT0000"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0000"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0002"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0004"}}, 0, false},
+		{"lustre_quota_slave_reintegration_running", "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise", gauge, []labelPair{{"component", "quota"}, {"quota_type", "user"}, {"target", "lustrefs-OST0006"}}, 0, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-MDT0000"}}, 6.259712e+06, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-OST0000"}}, 1.42260532224e+11, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-OST0002"}}, 1.0565632e+07, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-OST0004"}}, 1.0565632e+07, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-OST0006"}}, 1.0565632e+07, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-MDT0000"}}, 6.259712e+06, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-OST0000"}}, 1.42260532224e+11, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-OST0002"}}, 1.0565632e+07, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-OST0004"}}, 1.0565632e+07, false},
+		{"lustre_quota_used_bytes", "Number of bytes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-OST0006"}}, 1.0565632e+07, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-MDT0000"}}, 225, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-OST0000"}}, 251, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-OST0002"}}, 251, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-OST0004"}}, 251, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "group"}, {"target", "lustrefs-OST0006"}}, 251, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-MDT0000"}}, 225, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-OST0000"}}, 251, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-OST0002"}}, 251, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-OST0004"}}, 251, false},
+		{"lustre_quota_used_inodes", "Number of inodes used by the quota ID.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"quota_type", "user"}, {"target", "lustrefs-OST0006"}}, 251, false},
+		{"lustre_quota_grace_period_seconds", "Grace time in seconds given to quota IDs exceeding their soft limit in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 604800, false},
+		{"lustre_quota_grace_period_seconds", "Grace time in seconds given to quota IDs exceeding their soft limit in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 604800, false},
+		{"lustre_quota_grace_period_seconds", "Grace time in seconds given to quota IDs exceeding their soft limit in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 604800, false},
+		{"lustre_quota_grace_period_seconds", "Grace time in seconds given to quota IDs exceeding their soft limit in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 604800, false},
+		{"lustre_quota_grace_period_seconds", "Grace time in seconds given to quota IDs exceeding their soft limit in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 604800, false},
+		{"lustre_quota_grace_period_seconds", "Grace time in seconds given to quota IDs exceeding their soft limit in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 604800, false},
+		{"lustre_quota_granted_bytes", "Number of bytes of the quota limit of the quota ID granted to the quota slaves of the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_bytes", "Number of bytes of the quota limit of the quota ID granted to the quota slaves of the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_bytes", "Number of bytes of the quota limit of the quota ID granted to the quota slaves of the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_inodes", "Number of inodes of the quota limit of the quota ID granted to the quota slaves of the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_inodes", "Number of inodes of the quota limit of the quota ID granted to the quota slaves of the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_inodes", "Number of inodes of the quota limit of the quota ID granted to the quota slaves of the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_hard_limit_bytes", "Hard limit in bytes of the quota ID in the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_hard_limit_bytes", "Hard limit in bytes of the quota ID in the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_hard_limit_bytes", "Hard limit in bytes of the quota ID in the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_hard_limit_inodes", "Hard limit in inodes of the quota ID in the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_hard_limit_inodes", "Hard limit in inodes of the quota ID in the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_hard_limit_inodes", "Hard limit in inodes of the quota ID in the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_pool_entries", "Number of quota entries cached in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 1, false},
+		{"lustre_quota_pool_entries", "Number of quota entries cached in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 1, false},
+		{"lustre_quota_pool_entries", "Number of quota entries cached in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 1, false},
+		{"lustre_quota_pool_entries", "Number of quota entries cached in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 1, false},
+		{"lustre_quota_pool_entries", "Number of quota entries cached in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 1, false},
+		{"lustre_quota_pool_entries", "Number of quota entries cached in the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 1, false},
+		{"lustre_quota_pool_least_qunit", "Minimum quota unit of the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"target", "lustrefs-QMT0000"}}, 1024, false},
+		{"lustre_quota_pool_least_qunit", "Minimum quota unit of the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"target", "lustrefs-QMT0000"}}, 1024, false},
+		{"lustre_quota_pool_slaves", "Number of quota slaves connected to the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_pool_slaves", "Number of quota slaves connected to the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_pool_slaves", "Number of quota slaves connected to the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_pool_slaves", "Number of quota slaves connected to the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_pool_slaves", "Number of quota slaves connected to the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_pool_slaves", "Number of quota slaves connected to the quota pool.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_pool_soft_least_qunit", "Minimum quota unit of the quota pool for quota IDs exceeding their soft limit.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"target", "lustrefs-QMT0000"}}, 4096, false},
+		{"lustre_quota_pool_soft_least_qunit", "Minimum quota unit of the quota pool for quota IDs exceeding their soft limit.", gauge, []labelPair{{"component", "quota"}, {"pool", "0x0"}, {"pool_type", "md"}, {"target", "lustrefs-QMT0000"}}, 1024, false},
+		{"lustre_quota_soft_limit_bytes", "Soft limit in bytes of the quota ID in the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_soft_limit_bytes", "Soft limit in bytes of the quota ID in the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_soft_limit_bytes", "Soft limit in bytes of the quota ID in the data quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_soft_limit_inodes", "Soft limit in inodes of the quota ID in the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_soft_limit_inodes", "Soft limit in inodes of the quota ID in the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_soft_limit_inodes", "Soft limit in inodes of the quota ID in the metadata quota pool.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_over_soft_limit", "Returns '1' if the amount granted to the quota slaves for the quota ID exceeds its soft limit, '0' otherwise.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_over_soft_limit", "Returns '1' if the amount granted to the quota slaves for the quota ID exceeds its soft limit, '0' otherwise.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_over_soft_limit", "Returns '1' if the amount granted to the quota slaves for the quota ID exceeds its soft limit, '0' otherwise.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "dt"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_over_soft_limit", "Returns '1' if the amount granted to the quota slaves for the quota ID exceeds its soft limit, '0' otherwise.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "group"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_over_soft_limit", "Returns '1' if the amount granted to the quota slaves for the quota ID exceeds its soft limit, '0' otherwise.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "project"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+		{"lustre_quota_granted_over_soft_limit", "Returns '1' if the amount granted to the quota slaves for the quota ID exceeds its soft limit, '0' otherwise.", gauge, []labelPair{{"component", "quota"}, {"id", "0"}, {"pool", "0x0"}, {"pool_type", "md"}, {"quota_type", "user"}, {"target", "lustrefs-QMT0000"}}, 0, false},
+
 		//Health metrics
 		{"lustre_health_check", "Current health status for the indicated instance: 1 refers to 'healthy', 0 refers to 'unhealthy'", gauge, []labelPair{{"component", "health"}, {"target", "lustre"}}, 1, false},
 	}
@@ -1784,6 +3524,8 @@ func TestCollector(t *testing.T) {
 					value = *metric.Gauge.Value
 				} else if *metricFamily.Type == untyped {
 					value = *metric.Untyped.Value
+				} else if *metricFamily.Type == histogram {
+					value = float64(*metric.Histogram.SampleCount)
 				}
 				var labels []labelPair
 				for _, label := range metric.Label {
@@ -1825,6 +3567,7 @@ func TestCollector(t *testing.T) {
 	// Return the proc location to the default value
 	sources.ProcLocation = "/proc"
 	sources.SysLocation = "/sys"
+	sources.DebugLocation = "/sys/kernel/debug"
 
 	sources.LctlCommandMode = true
 }
@@ -0,0 +1,220 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"io/ioutil"
+	"os/user"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// Valid values of ResolveIDs
+	resolveIDsLabel   string = "label"
+	resolveIDsReplace string = "replace"
+
+	// idCacheTTL is the time after which resolved names are looked up again
+	idCacheTTL = 10 * time.Minute
+)
+
+var (
+	// ResolveIDs specifies whether user, group and project IDs of the quota and job metrics are resolved to names.
+	// Valid values are 'disabled', 'label' to add the names as labels and 'replace' to report the names instead of the IDs.
+	ResolveIDs string
+	// ProjectIDFile maps project names to project IDs, one '{name}:{id}' per line like '/etc/projid'
+	ProjectIDFile = "/etc/projid"
+	// IDOverrideFile optionally holds static names taking precedence over NSS and ProjectIDFile,
+	// one '{user|group|project}:{id}:{name}' per line
+	IDOverrideFile string
+	// JobIDPattern matches the user and group ID within the job IDs with the named groups 'uid' and 'gid'.
+	// The default matches job IDs in Lustre's default 'jobid_name' format '%e.%u'.
+	JobIDPattern = regexp.MustCompile(`^.+\.(?P<uid>\d+)$`)
+
+	// idNameLabels are the labels added to the quota metrics, one per quota type
+	idNameLabels = []string{"user", "group", "project"}
+	// jobIDGroups maps the named groups of JobIDPattern to the quota type of the matched ID
+	jobIDGroups = map[string]string{"uid": "user", "gid": "group"}
+
+	resolver = newIDResolver()
+)
+
+type idCacheEntry struct {
+	name    string
+	expires time.Time
+}
+
+type idResolver struct {
+	mutex sync.Mutex
+	// names caches the names by quota type and ID, including IDs without name
+	names map[string]idCacheEntry
+	// overrides holds the content of IDOverrideFile, which is only read once
+	overrides map[string]string
+	// projects holds the content of ProjectIDFile, which is read again after idCacheTTL
+	projects        map[string]string
+	projectsExpires time.Time
+	// lookup functions of NSS, replaceable for testing
+	lookupUser  func(string) (*user.User, error)
+	lookupGroup func(string) (*user.Group, error)
+}
+
+func newIDResolver() *idResolver {
+	return &idResolver{
+		names:       map[string]idCacheEntry{},
+		lookupUser:  user.LookupId,
+		lookupGroup: user.LookupGroupId,
+	}
+}
+
+// parseIDOverrides parses the lines '{user|group|project}:{id}:{name}' of the override file
+func parseIDOverrides(overrideFile string) map[string]string {
+	overrides := map[string]string{}
+	for _, line := range strings.Split(overrideFile, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		overrides[fields[0]+":"+fields[1]] = fields[2]
+	}
+	return overrides
+}
+
+// parseProjectIDs parses the lines '{name}:{id}' of the project ID file
+func parseProjectIDs(projectIDFile string) map[string]string {
+	projects := map[string]string{}
+	for _, line := range strings.Split(projectIDFile, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Split(line, ":")
+		if len(fields) < 2 {
+			continue
+		}
+		projects[fields[1]] = fields[0]
+	}
+	return projects
+}
+
+// resolve returns the name of the ID of the given quota type ('user', 'group' or 'project'),
+// or an empty string if the ID has no name
+func (r *idResolver) resolve(quotaType string, id string) string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.overrides == nil {
+		r.overrides = map[string]string{}
+		if IDOverrideFile != "" {
+			overrideFile, err := ioutil.ReadFile(filepath.Clean(IDOverrideFile))
+			if err != nil {
+				log.Error(err)
+			} else {
+				r.overrides = parseIDOverrides(string(overrideFile))
+			}
+		}
+	}
+	key := quotaType + ":" + id
+	if name, ok := r.overrides[key]; ok {
+		return name
+	}
+	now := time.Now()
+	if entry, ok := r.names[key]; ok && now.Before(entry.expires) {
+		return entry.name
+	}
+
+	var name string
+	switch quotaType {
+	case "user":
+		if u, err := r.lookupUser(id); err == nil {
+			name = u.Username
+		}
+	case "group":
+		if g, err := r.lookupGroup(id); err == nil {
+			name = g.Name
+		}
+	case "project":
+		if now.After(r.projectsExpires) {
+			r.projects = map[string]string{}
+			r.projectsExpires = now.Add(idCacheTTL)
+			// Project quotas are usually used without naming the projects, hence a missing file is no error
+			if projectIDFile, err := ioutil.ReadFile(filepath.Clean(ProjectIDFile)); err == nil {
+				r.projects = parseProjectIDs(string(projectIDFile))
+			}
+		}
+		name = r.projects[id]
+	}
+	r.names[key] = idCacheEntry{name: name, expires: now.Add(idCacheTTL)}
+	return name
+}
+
+// resolveQuotaID returns the labels holding the name of the ID of a quota metric and the value of its 'id' label.
+// With ResolveIDs set to 'label' the 'user', 'group' and 'project' labels are added, only the one of the quota
+// type being set. With 'replace' the ID is replaced by its name, if it has one.
+func resolveQuotaID(quotaType string, id string) (labels []string, labelValues []string, idValue string) {
+	switch ResolveIDs {
+	case resolveIDsLabel:
+		labelValues = make([]string, len(idNameLabels))
+		for i, label := range idNameLabels {
+			if label == quotaType {
+				labelValues[i] = resolver.resolve(quotaType, id)
+			}
+		}
+		return idNameLabels, labelValues, id
+	case resolveIDsReplace:
+		if name := resolver.resolve(quotaType, id); name != "" {
+			return nil, nil, name
+		}
+	}
+	return nil, nil, id
+}
+
+// resolveJobID returns the labels holding the names of the IDs matched by JobIDPattern within the job ID
+// and the value of the 'jobid' label. With ResolveIDs set to 'replace' the IDs are replaced by their names.
+func resolveJobID(jobid string) (labels []string, labelValues []string, jobidValue string) {
+	if ResolveIDs != resolveIDsLabel && ResolveIDs != resolveIDsReplace {
+		return nil, nil, jobid
+	}
+	match := JobIDPattern.FindStringSubmatchIndex(jobid)
+	jobidValue = jobid
+	// Replace from the end of the job ID, so the indices of the preceding IDs stay valid
+	groupNames := JobIDPattern.SubexpNames()
+	for i := len(groupNames) - 1; i > 0; i-- {
+		quotaType, ok := jobIDGroups[groupNames[i]]
+		if !ok {
+			continue
+		}
+		var name string
+		if match != nil && match[2*i] >= 0 {
+			name = resolver.resolve(quotaType, jobid[match[2*i]:match[2*i+1]])
+		}
+		if ResolveIDs == resolveIDsReplace {
+			if name != "" {
+				jobidValue = jobidValue[:match[2*i]] + name + jobidValue[match[2*i+1]:]
+			}
+			continue
+		}
+		labels = append([]string{quotaType}, labels...)
+		labelValues = append([]string{name}, labelValues...)
+	}
+	return labels, labelValues, jobidValue
+}
@@ -0,0 +1,111 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func setupTestResolver(t *testing.T) func() {
+	dir, err := ioutil.TempDir("", "id_resolver")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ProjectIDFile = filepath.Join(dir, "projid")
+	IDOverrideFile = filepath.Join(dir, "override")
+	if err := ioutil.WriteFile(ProjectIDFile, []byte("# name:id\nscratch:100\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(IDOverrideFile, []byte("user:1001:service\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	resolver = newIDResolver()
+	resolver.lookupUser = func(id string) (*user.User, error) {
+		if id == "1000" || id == "1001" {
+			return &user.User{Uid: id, Username: "alice"}, nil
+		}
+		return nil, user.UnknownUserIdError(0)
+	}
+	resolver.lookupGroup = func(id string) (*user.Group, error) {
+		return nil, fmt.Errorf("group %s not found", id)
+	}
+	return func() {
+		os.RemoveAll(dir)
+		ResolveIDs = ""
+		ProjectIDFile = "/etc/projid"
+		IDOverrideFile = ""
+		resolver = newIDResolver()
+	}
+}
+
+func TestResolveQuotaID(t *testing.T) {
+	defer setupTestResolver(t)()
+
+	testCases := []struct {
+		mode        string
+		quotaType   string
+		id          string
+		labelValues []string
+		idValue     string
+	}{
+		{"", "user", "1000", nil, "1000"},
+		{resolveIDsLabel, "user", "1000", []string{"alice", "", ""}, "1000"},
+		{resolveIDsLabel, "user", "1001", []string{"service", "", ""}, "1001"},
+		{resolveIDsLabel, "group", "1000", []string{"", "", ""}, "1000"},
+		{resolveIDsLabel, "project", "100", []string{"", "", "scratch"}, "100"},
+		{resolveIDsReplace, "project", "100", nil, "scratch"},
+		{resolveIDsReplace, "user", "1002", nil, "1002"},
+	}
+	for _, tc := range testCases {
+		ResolveIDs = tc.mode
+		labels, labelValues, idValue := resolveQuotaID(tc.quotaType, tc.id)
+		if !reflect.DeepEqual(labelValues, tc.labelValues) || idValue != tc.idValue {
+			t.Fatalf("Retrieved unexpected labels for %s %s in mode %q. Expected: %v %s, Got: %v %v %s", tc.quotaType, tc.id, tc.mode, tc.labelValues, tc.idValue, labels, labelValues, idValue)
+		}
+		if labelValues != nil && !reflect.DeepEqual(labels, idNameLabels) {
+			t.Fatalf("Retrieved unexpected label names. Expected: %v, Got: %v", idNameLabels, labels)
+		}
+	}
+}
+
+func TestResolveJobID(t *testing.T) {
+	defer setupTestResolver(t)()
+
+	testCases := []struct {
+		mode        string
+		jobid       string
+		labels      []string
+		labelValues []string
+		jobidValue  string
+	}{
+		{"", "dd.1000", nil, nil, "dd.1000"},
+		{resolveIDsLabel, "dd.1000", []string{"user"}, []string{"alice"}, "dd.1000"},
+		{resolveIDsLabel, "23", []string{"user"}, []string{""}, "23"},
+		{resolveIDsReplace, "dd.1000", nil, nil, "dd.alice"},
+		{resolveIDsReplace, "dd.1002", nil, nil, "dd.1002"},
+	}
+	for _, tc := range testCases {
+		ResolveIDs = tc.mode
+		labels, labelValues, jobidValue := resolveJobID(tc.jobid)
+		if !reflect.DeepEqual(labels, tc.labels) || !reflect.DeepEqual(labelValues, tc.labelValues) || jobidValue != tc.jobidValue {
+			t.Fatalf("Retrieved unexpected labels for %q in mode %q. Expected: %v %v %s, Got: %v %v %s", tc.jobid, tc.mode, tc.labels, tc.labelValues, tc.jobidValue, labels, labelValues, jobidValue)
+		}
+	}
+}
@@ -55,6 +55,12 @@ type lustreStatsMetric struct {
 	extraLabelValue string
 }
 
+type lustreHistogramMetric struct {
+	operation string
+	count     uint64
+	buckets   map[float64]uint64
+}
+
 type lustreHelpStruct struct {
 	filename        string
 	promName        string // Name to be used in Prometheus
@@ -132,6 +138,21 @@ func parseFileElements(path string, directoryDepth int) (name string, nodeName s
 	return name, nodeName, nil
 }
 
+// parseOSCDeviceName splits the name of a client OSC device, e.g. 'lustrefs-OST0000-osc-ffff88105db50000',
+// into the name of the OST ('lustrefs-OST0000') and the name of the client mount ('lustrefs-ffff88105db50000').
+func parseOSCDeviceName(deviceName string) (ostName string, mount string) {
+	i := strings.Index(deviceName, "-osc-")
+	if i < 0 {
+		return deviceName, ""
+	}
+	ostName, instance := deviceName[:i], deviceName[i+len("-osc-"):]
+	fsName := ostName
+	if i := strings.LastIndex(ostName, "-"); i >= 0 {
+		fsName = ostName[:i]
+	}
+	return ostName, fsName + "-" + instance
+}
+
 func convertToBytes(s string) string {
 	if len(s) < 1 {
 		return s
@@ -161,6 +182,12 @@ func convertToBytes(s string) string {
 	return strconv.FormatUint(byteVal, 10)
 }
 
+// convertToBucketBound returns the upper bound of a histogram bucket given as row
+// name in brw_stats-like files (e.g. '4:', '1K:' or '16M:').
+func convertToBucketBound(s string) (float64, error) {
+	return strconv.ParseFloat(convertToBytes(strings.TrimSuffix(s, ":")), 64)
+}
+
 func parseClientIP(path string) (string, error) {
 	pathElements := strings.Split(path, "/")
 	if len(pathElements) < 2 {
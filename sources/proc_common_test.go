@@ -138,6 +138,24 @@ func TestParseFileElements(t *testing.T) {
 	}
 }
 
+func TestParseOSCDeviceName(t *testing.T) {
+	testCases := []struct {
+		deviceName string
+		ostName    string
+		mount      string
+	}{
+		{"lustrefs-OST0000-osc-ffff88105db50000", "lustrefs-OST0000", "lustrefs-ffff88105db50000"},
+		{"my-fs-OST000a-osc-ffff9d6b7c1e2800", "my-fs-OST000a", "my-fs-ffff9d6b7c1e2800"},
+		{"lustrefs-MDT0000-mdc-ffff88105db50000", "lustrefs-MDT0000-mdc-ffff88105db50000", ""},
+	}
+	for _, tc := range testCases {
+		ostName, mount := parseOSCDeviceName(tc.deviceName)
+		if ostName != tc.ostName || mount != tc.mount {
+			t.Fatalf("Retrieved an unexpected name. Expected: %s, %s, Got: %s, %s", tc.ostName, tc.mount, ostName, mount)
+		}
+	}
+}
+
 func TestConvertToBytes(t *testing.T) {
 	var resultString string
 	testStringResults := map[string]string{
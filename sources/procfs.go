@@ -15,14 +15,17 @@ package sources
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -39,16 +42,23 @@ const (
 	statsHelp        string = "Number of operations the filesystem has performed."
 
 	// Help text dedicated to the 'brw_stats' file
-	pagesPerBlockRWHelp    string = "Total number of pages per block RPC."
-	discontiguousPagesHelp string = "Total number of logical discontinuities per RPC."
-	ioTimeHelp             string = "Total time in milliseconds the filesystem has spent processing various object sizes."
-	diskIOSizeHelp         string = "Total number of operations the filesystem has performed for the given size."
-	diskIOsInFlightHelp    string = "Current number of I/O operations that are processing during the snapshot."
+	pagesPerBlockRWHelp     string = "Histogram of pages per bulk read/write RPC. The sum is not reported by Lustre and always 0."
+	discontiguousPagesHelp  string = "Histogram of logical page discontinuities per RPC. The sum is not reported by Lustre and always 0."
+	discontiguousBlocksHelp string = "Histogram of logical block discontinuities per RPC. The sum is not reported by Lustre and always 0."
+	diskFragmentedIOsHelp   string = "Histogram of disk I/Os a single RPC has been split into. The sum is not reported by Lustre and always 0."
+	diskIOsInFlightHelp     string = "Histogram of disk I/Os in flight when a disk I/O has been submitted. The sum is not reported by Lustre and always 0."
+	ioTimeHelp              string = "Histogram of time in milliseconds the filesystem has spent processing disk I/Os. The sum is not reported by Lustre and always 0."
+	diskIOSizeHelp          string = "Histogram of disk I/O sizes in bytes. The sum is not reported by Lustre and always 0."
+
+	// Help text dedicated to the 'rename_stats' file
+	renameDirSizeHelp string = "Histogram of the size in bytes of the directories renames have been performed in per kind. The sum is not reported by Lustre and always 0."
 
 	// Help text dedicated to the 'rpc_stats' file
-	pagesPerRPCHelp  string = "Total number of pages per RPC."
-	rpcsInFlightHelp string = "Current number of RPCs that are processing during the snapshot."
-	offsetHelp       string = "Current RPC offset by size."
+	currentRPCsInFlightHelp string = "Current number of RPCs in flight."
+	pendingPagesHelp        string = "Current number of pages pending to be sent."
+	pagesPerRPCHelp         string = "Histogram of pages per RPC. The sum is not reported by Lustre and always 0."
+	rpcsInFlightHelp        string = "Histogram of RPCs in flight when an RPC has been sent. The sum is not reported by Lustre and always 0."
+	offsetHelp              string = "Histogram of RPC offsets in pages. The sum is not reported by Lustre and always 0."
 
 	// Help text dedicated to the 'encrypt_page_pools' file
 	physicalPagesHelp     string = "Capacity of physical memory."
@@ -67,9 +77,236 @@ const (
 	maxWaitQueueDepthHelp string = "Maximum waitqueue length."
 	outOfMemHelp          string = "Total number of out of memory requests."
 
+	// Help text dedicated to the 'read_ahead_stats' file
+	readAheadHitsHelp                string = "Total number of pages read from the read-ahead cache."
+	readAheadMissesHelp              string = "Total number of pages not found in the read-ahead cache."
+	readAheadNotConsecutiveHelp      string = "Total number of pages read with a non-consecutive readpage."
+	readAheadMissInsideWindowHelp    string = "Total number of misses inside the read-ahead window."
+	readAheadFailedGrabCachePageHelp string = "Total number of pages the read-ahead failed to grab from the page cache."
+	readAheadReadButDiscardedHelp    string = "Total number of read-ahead pages discarded without being read."
+	readAheadZeroLengthFileHelp      string = "Total number of read-ahead attempts on zero length files."
+	readAheadZeroSizeWindowHelp      string = "Total number of read-ahead attempts with a zero size window."
+	readAheadToEOFHelp               string = "Total number of read-ahead attempts reaching the end of file."
+	readAheadHitMaxIssueHelp         string = "Total number of read-ahead attempts limited by the maximum read-ahead size."
+	readAheadWrongRangeHelp          string = "Total number of read-ahead attempts for a wrong range."
+	readAheadFailedToReachEndHelp    string = "Total number of read-ahead attempts that failed to reach the end of the window."
+	readAheadFailedToFastReadHelp    string = "Total number of failed fast reads."
+	readAheadAsyncHelp               string = "Total number of asynchronous read-ahead attempts."
+
+	// Help text dedicated to the 'extents_stats', 'extents_stats_per_process' and 'offset_stats' files
+	extentsSizeHelp        string = "Histogram of the size of the read and write extents issued on the mount. The sum is not reported by Lustre and always 0."
+	processExtentsSizeHelp string = "Histogram of the size of the read and write extents issued on the mount by a process. The sum is not reported by Lustre and always 0."
+	offsetRangeSizeHelp    string = "Histogram of the size of the contiguous file ranges read or written by processes. The sum is not reported by Lustre and always 0."
+
+	// Help text dedicated to the 'osc_cached_mb', 'osc_stats' and 'unstable_stats' files
+	oscCachedHelp          string = "Size of the page cache in megabytes used by the client for this OST"
+	oscCachedBusyHelp      string = "Number of busy pages in the page cache of the client for this OST"
+	oscCachedReclaimHelp   string = "Total number of pages reclaimed from the page cache of the client for this OST"
+	locklessWriteBytesHelp string = "Total number of bytes written to this OST without taking a lock"
+	locklessReadBytesHelp  string = "Total number of bytes read from this OST without taking a lock"
+	locklessTruncateHelp   string = "Total number of truncates issued to this OST without taking a lock"
+	unstablePagesHelp      string = "Number of pages sent to this OST but not yet committed to stable storage"
+	unstableMegabytesHelp  string = "Size in megabytes of the data sent to this OST but not yet committed to stable storage"
+	checksumTypeHelp       string = "Checksum algorithm used by the client for this OST, the selected algorithm is given in the 'algorithm' label"
+
+	// Help text dedicated to the 'import' and 'timeouts' files
+	importStateHelp              string = "Returns '1' for the current state of the import given in the 'state' label, '0' otherwise"
+	importConnectionHelp         string = "Returns '1' for the NID of the server the import is currently connected to, given in the 'nid' label"
+	importFailoverNIDsHelp       string = "Number of failover NIDs configured for the import"
+	importConnectionAttemptsHelp string = "Total number of connection attempts of the import"
+	importRPCsInFlightHelp       string = "Number of RPCs in flight on the import"
+	importRPCTimeoutsHelp        string = "Total number of RPC timeouts on the import"
+	importAverageWaitTimeHelp    string = "Average time in microseconds RPCs of the import waited for a reply"
+	importServiceEstimateHelp    string = "Adaptive timeout estimate in seconds of the import, the estimate is given in the 'estimate' label"
+	importATEstimateHelp         string = "Adaptive timeout estimate in seconds of the import per portal, the current or worst estimate is given in the 'estimate' label"
+	importATNetworkLatencyHelp   string = "Adaptive network latency estimate in seconds of the import, the current or worst estimate is given in the 'estimate' label"
+	importWriteBytesPerRPCHelp   string = "Average number of bytes written per bulk RPC on the import"
+	importWriteTimePerRPCHelp    string = "Average time in microseconds spent per bulk write RPC on the import"
+	importWriteThroughputHelp    string = "Average write throughput of the import in megabytes per second"
+	importInvalidationsHelp      string = "Total number of invalidations of the import, e.g. after an eviction by the target"
+
+	// Help text dedicated to the precreated objects of an OSP device, derived from 'prealloc_last_id' and 'prealloc_next_id'
+	precreatedObjectsHelp string = "Number of objects precreated on the target and not yet used by the MDT"
+
+	// Help text dedicated to the 'target_obd' file
+	lodTargetActiveHelp string = "Returns '1' if the OST given in the 'ost' label is active in the LOD, '0' otherwise"
+	lovTargetActiveHelp string = "Returns '1' if the OST given in the 'ost' label is active in the LOV of the mount, '0' otherwise"
+	lmvTargetActiveHelp string = "Returns '1' if the MDT given in the 'mdt' label is active in the LMV of the mount, '0' otherwise"
+
+	// Help text dedicated to the OST pools defined in 'pools/{pool}'
+	poolMemberHelp    string = "Returns '1' if the OST given in the 'ost' label is a member of the pool"
+	poolCapacityHelp  string = "Capacity of the OSTs of the pool in kilobytes"
+	poolFreeHelp      string = "Number of kilobytes free on the OSTs of the pool"
+	poolAvailableHelp string = "Number of kilobytes readily available on the OSTs of the pool"
+
+	// Help text dedicated to the 'stats' file of the PTLRPC services
+	serviceRequestsHelp          string = "Total number of requests handled by the service."
+	serviceWaitTimeHelp          string = "Total time in microseconds requests waited before being handled by the service."
+	serviceWaitTimeMaximumHelp   string = "Maximum time in microseconds a request waited before being handled by the service."
+	serviceQueueDepthHelp        string = "Sum of the request queue depths sampled when requests arrived at the service."
+	serviceQueueDepthMaximumHelp string = "Maximum request queue depth of the service."
+	serviceActiveHelp            string = "Sum of the number of active requests sampled when requests were handled by the service."
+	serviceActiveMaximumHelp     string = "Maximum number of requests handled concurrently by the service."
+	serviceTimeoutMaximumHelp    string = "Maximum timeout in seconds given to requests of the service."
+	serviceATEstimateHelp        string = "Adaptive timeout estimate in seconds of the service, the current or worst estimate is given in the 'estimate' label"
+	serviceBuffersMinimumHelp    string = "Minimum number of request buffers available to the service."
+	serviceOperationsHelp        string = "Total number of requests handled by the service per operation."
+	serviceOperationTimeHelp     string = "Total time in microseconds spent handling requests of the service per operation."
+
+	// Help text dedicated to the 'nrs_policies' and 'nrs_tbf_rule' files
+	nrsPolicyStartedHelp   string = "Returns '1' if the NRS policy is started for the queue of the service, '0' otherwise"
+	nrsPolicyQueuedHelp    string = "Number of requests queued in the NRS policy of the service"
+	nrsPolicyActiveHelp    string = "Number of requests being handled by the NRS policy of the service"
+	nrsTBFRuleRateHelp     string = "Configured rate limit in RPCs per second of the TBF rule"
+	nrsTBFRuleRefCountHelp string = "Number of request classes currently referencing the TBF rule"
+
+	// Help text dedicated to the 'ldlm_stats' file of the exports
+	ldlmStatsHelp string = "Number of LDLM lock operations requested by the client."
+
+	// Help text dedicated to the 'quota_slave' files
+	quotaUsedInodesHelp          string = "Number of inodes used by the quota ID."
+	quotaUsedBytesHelp           string = "Number of bytes used by the quota ID."
+	quotaSlaveEnforcedHelp       string = "Returns '1' if quota enforcement is enabled for the quota type on the target, '0' otherwise"
+	quotaSlaveAccountingHelp     string = "Returns '1' if space accounting is enabled for the quota type on the target, '0' otherwise"
+	quotaSlaveConnectedHelp      string = "Returns '1' if the quota slave of the target is connected to the quota master, '0' otherwise"
+	quotaSlaveGlobalUptodateHelp string = "Returns '1' if the copy of the global quota index of the target is up to date, '0' otherwise"
+	quotaSlaveLocalUptodateHelp  string = "Returns '1' if the slave quota index of the target is up to date, '0' otherwise"
+	quotaSlaveReintegrationHelp  string = "Returns '1' if the quota reintegration of the target is in progress, '0' otherwise"
+
+	// Help text dedicated to the quota master (QMT) files
+	qmtHardLimitBytesHelp  string = "Hard limit in bytes of the quota ID in the data quota pool."
+	qmtHardLimitInodesHelp string = "Hard limit in inodes of the quota ID in the metadata quota pool."
+	qmtSoftLimitBytesHelp  string = "Soft limit in bytes of the quota ID in the data quota pool."
+	qmtSoftLimitInodesHelp string = "Soft limit in inodes of the quota ID in the metadata quota pool."
+	qmtGrantedBytesHelp    string = "Number of bytes of the quota limit of the quota ID granted to the quota slaves of the data quota pool."
+	qmtGrantedInodesHelp   string = "Number of inodes of the quota limit of the quota ID granted to the quota slaves of the metadata quota pool."
+	qmtGrantedOverSoftHelp string = "Returns '1' if the amount granted to the quota slaves for the quota ID exceeds its soft limit, '0' otherwise."
+	qmtGraceExpiryHelp     string = "Time in seconds since the epoch at which the grace time of the quota ID exceeding its soft limit expires."
+	qmtGracePeriodHelp     string = "Grace time in seconds given to quota IDs exceeding their soft limit in the quota pool."
+	qmtLeastQunitHelp      string = "Minimum quota unit of the quota pool."
+	qmtSoftLeastQunitHelp  string = "Minimum quota unit of the quota pool for quota IDs exceeding their soft limit."
+	qmtPoolSlavesHelp      string = "Number of quota slaves connected to the quota pool."
+	qmtPoolEntriesHelp     string = "Number of quota entries cached in the quota pool."
+
+	// Help text dedicated to the HSM coordinator files
+	hsmActionsHelp          string = "Number of HSM actions in the action list of the coordinator per action type and status."
+	hsmActiveRequestsHelp   string = "Number of HSM requests being handled by the copytools."
+	hsmAgentsHelp           string = "Number of copytool agents registered to the coordinator."
+	hsmAgentCurrentHelp     string = "Number of HSM requests currently handled by the copytool agent."
+	hsmAgentRequestsHelp    string = "Total number of HSM requests handled by the copytool agent per result."
+	hsmCoordinatorStateHelp string = "Returns '1' if the HSM coordinator is in the given state, '0' otherwise"
+	hsmPolicyEnabledHelp    string = "Returns '1' if the HSM coordinator policy is enabled, '0' otherwise"
+
+	// Help text dedicated to the 'recovery_status' file
+	recoveryStatusHelp            string = "Returns '1' if the recovery of the target is in the given status, '0' otherwise"
+	recoveryStartHelp             string = "Time in seconds since the epoch at which the last recovery of the target started."
+	recoveryDurationHelp          string = "Duration in seconds of the last completed recovery of the target."
+	recoveryTimeRemainingHelp     string = "Time in seconds remaining until the running recovery of the target times out."
+	recoveryTimeWaitedHelp        string = "Time in seconds the recovery of the target has been waiting for other targets."
+	recoveryCompletedClientsHelp  string = "Number of clients which completed the recovery of the target."
+	recoveryClientsHelp           string = "Number of clients expected to take part in the recovery of the target."
+	recoveryConnectedClientsHelp  string = "Number of clients reconnected during the running recovery of the target."
+	recoveryReqReplayClientsHelp  string = "Number of clients replaying requests during the running recovery of the target."
+	recoveryLockReplayClientsHelp string = "Number of clients replaying locks during the running recovery of the target."
+	recoveryEvictedClientsHelp    string = "Number of clients evicted during the running recovery of the target."
+	recoveryReplayedRequestsHelp  string = "Number of requests replayed during the recovery of the target."
+	recoveryQueuedRequestsHelp    string = "Number of requests queued for replay during the running recovery of the target."
+
+	// Help text dedicated to the 'site_stats' file
+	siteBusyObjectsHelp    string = "Number of objects in the object cache of the target being in use."
+	siteObjectsHelp        string = "Number of objects in the object cache of the target."
+	siteBucketsUsedHelp    string = "Number of hash buckets of the object cache of the target holding objects."
+	siteBucketsHelp        string = "Number of hash buckets of the object cache of the target."
+	siteMaxSearchDepthHelp string = "Maximum number of objects searched in a hash bucket of the object cache of the target."
+	siteCreatedHelp        string = "Total number of objects created in the object cache of the target."
+	siteCacheHitsHelp      string = "Total number of lookups of the object cache of the target finding the object."
+	siteCacheMissesHelp    string = "Total number of lookups of the object cache of the target not finding the object."
+	siteCacheRacesHelp     string = "Total number of objects created concurrently in the object cache of the target."
+	siteDeathRacesHelp     string = "Total number of lookups of the object cache of the target finding an object being freed."
+	siteLRUPurgedHelp      string = "Total number of objects purged from the LRU of the object cache of the target."
+
+	// Help text dedicated to the 'hash_stats' file
+	hashBucketsHelp        string = "Current number of buckets of the hash table, the hash table is given in the 'hash' label"
+	hashBucketsMaximumHelp string = "Maximum number of buckets the hash table can grow to, the hash table is given in the 'hash' label"
+	hashItemsHelp          string = "Number of items in the hash table, the hash table is given in the 'hash' label"
+	hashLoadFactorHelp     string = "Average number of items per bucket of the hash table, the hash table is given in the 'hash' label"
+	hashRehashesHelp       string = "Total number of times the hash table has been resized, the hash table is given in the 'hash' label"
+	hashMaxDepthHelp       string = "Maximum number of items in a bucket of the hash table, the hash table is given in the 'hash' label"
+
+	// Help text dedicated to the 'filesystems' and 'live/*' files of the MGS
+	mgsFilesystemsHelp         string = "Number of filesystems registered with the MGS."
+	mgsTargetsHelp             string = "Number of targets of the filesystem registered with the MGS per target type."
+	mgsIRStateHelp             string = "Returns '1' if the imperative recovery of the filesystem is in the given state, '0' otherwise"
+	mgsIRNonIRClientsHelp      string = "Number of clients of the filesystem not supporting imperative recovery."
+	mgsIRNidtblVersionHelp     string = "Version of the NID table of the filesystem sent to the clients for imperative recovery."
+	mgsIRNotifyCountHelp       string = "Total number of imperative recovery notifications sent to the clients of the filesystem."
+	mgsIRNotifyDurationHelp    string = "Total time in seconds spent notifying the clients of the filesystem for imperative recovery."
+	mgsIRNotifyDurationMaxHelp string = "Maximum time in seconds spent notifying the clients of the filesystem for imperative recovery."
+
+	// Help text dedicated to the 'lfsck_layout', 'lfsck_namespace' and 'oi_scrub' files
+	lfsckStatusHelp           string = "Returns '1' if the LFSCK component is in the given status, '0' otherwise"
+	lfsckSuccessHelp          string = "Total number of successfully completed runs of the LFSCK component."
+	lfsckCheckedHelp          string = "Number of objects checked by the current or last run of the LFSCK component per phase."
+	lfsckFailedHelp           string = "Number of objects the current or last run of the LFSCK component failed to check per phase."
+	lfsckRepairedHelp         string = "Number of inconsistencies repaired by the current or last run of the LFSCK component per inconsistency."
+	lfsckRunTimeHelp          string = "Time in seconds spent by the current or last run of the LFSCK component per phase."
+	lfsckSpeedHelp            string = "Average number of objects per second checked by the current or last run of the LFSCK component per phase."
+	lfsckSinceCompletedHelp   string = "Time in seconds since the last run of the LFSCK component completed."
+	oiScrubStatusHelp         string = "Returns '1' if the OI scrub is in the given status, '0' otherwise"
+	oiScrubSuccessHelp        string = "Total number of successfully completed OI scrub runs."
+	oiScrubCheckedHelp        string = "Number of objects checked by the current or last OI scrub run."
+	oiScrubUpdatedHelp        string = "Number of OI entries updated by the current or last OI scrub run."
+	oiScrubFailedHelp         string = "Number of objects the current or last OI scrub run failed to check."
+	oiScrubRunTimeHelp        string = "Time in seconds spent by the current or last OI scrub run."
+	oiScrubSpeedHelp          string = "Average number of objects per second checked by the current or last OI scrub run."
+	oiScrubSinceCompletedHelp string = "Time in seconds since the last OI scrub run completed."
+
+	// Help text dedicated to the 'statahead_stats' file
+	stataheadTotalHelp  string = "Total number of statahead instances started."
+	stataheadWrongHelp  string = "Total number of statahead instances stopped because of a non-sequential directory traversal."
+	stataheadAGLHelp    string = "Total number of Asynchronous Glimpse Lock (AGL) instances started."
+	stataheadHitsHelp   string = "Total number of statahead cache hits."
+	stataheadMissesHelp string = "Total number of statahead cache misses."
+
 	//repeated strings replaced by constants
 	mdStats          string = "md_stats"
 	encryptPagePools string = "encrypt_page_pools"
+	readAheadStats   string = "read_ahead_stats"
+	stataheadStats   string = "statahead_stats"
+	oscCachedMB      string = "osc_cached_mb"
+	oscStats         string = "osc_stats"
+	unstableStats    string = "unstable_stats"
+	checksumType     string = "checksum_type"
+	precreated       string = "precreated"
+	lod              string = "lod"
+	targetObd        string = "target_obd"
+	serviceStats     string = "service_stats"
+	ldlmStats        string = "ldlm_stats"
+
+	// ostServicesPath and mdsServicesPath match the PTLRPC services of the OSS and MDS, e.g. 'ost_io' or 'mdt_readpage'
+	ostServicesPath string = "ost/OSS/*"
+	mdsServicesPath string = "mds/MDS/*"
+	// mgsServicesPath matches the PTLRPC service of the MGS
+	mgsServicesPath string = "mgs/MGS/mgs"
+	// ldlmServicesPath matches the LDLM callback and cancel services, 'ldlm_cbd' and 'ldlm_canceld'
+	ldlmServicesPath string = "ldlm/services/*"
+
+	// clientOSCPath only matches the OSC devices of client mounts, e.g. 'lustrefs-OST0000-osc-ffff88105db50000'.
+	// The OSC devices used by MDTs to reach the OSTs are named '{OST}-osc-MDT{index}'.
+	clientOSCPath string = "osc/*-osc-[0-9a-f]*"
+
+	// quotaAccountingFiles matches the 'acct_user', 'acct_group' and 'acct_project' files of the quota slaves
+	quotaAccountingFiles string = "quota_slave/acct_*"
+	quotaSlaveInfo       string = "quota_slave/info"
+
+	// qmtGlobalIndexFiles matches the 'glb-usr', 'glb-grp' and 'glb-prj' files of the quota pools, e.g. 'dt-0x0'
+	qmtGlobalIndexFiles string = "*/glb-*"
+	qmtPoolInfo         string = "*/info"
+	qmtSoftLeastQunit   string = "*/soft_least_qunit"
+
+	// Valid values of QuotaAggregation
+	quotaPerTarget     string = "target"
+	quotaPerFilesystem string = "filesystem"
 )
 
 var (
@@ -85,17 +322,70 @@ var (
 	ClientEnabled string
 	// GenericEnabled specifies whether to collect Generic metrics
 	GenericEnabled string
+	// QuotaEnabled specifies whether to collect Quota metrics
+	QuotaEnabled string
+	// QuotaAggregation specifies whether the quota usage is reported per target or summed up per filesystem
+	QuotaAggregation string
+	// QuotaIDs restricts the quota usage to the given user, group and project IDs. All IDs are collected if empty.
+	QuotaIDs []string
+	// ClientExtentsTopProcesses specifies the number of processes with the most I/O calls for which
+	// the per-process extent histograms are collected. A value of 0 disables the per-process histograms.
+	ClientExtentsTopProcesses int
 )
 
-type lustreJobsMetric struct {
-	jobID string
+var (
+	rpcsInFlightRegexPattern = regexp.MustCompile(`(?m:^(read|write|modify)[ _]RPCs[ _]in[ _]flight: *(\d+))`)
+	pendingPagesRegexPattern = regexp.MustCompile(`(?m:^pending (read|write) pages: *(\d+))`)
+	// extentsRegexPattern matches extent rows such as '   4K -    8K :     12   50   50  |      3  100  100'.
+	// Older Lustre versions print a NUL byte in front of the colon, which is swallowed by '\S*'.
+	extentsRegexPattern = regexp.MustCompile(`^ *\d+[KMG]? *- *(\d+[KMG]?)\S* *: *(\d+) +\d+ +\d+ *\| *(\d+)`)
+	pidRegexPattern     = regexp.MustCompile(`^PID: *(\d+)`)
+	// nrsTBFRuleRegexPattern matches TBF rules such as 'default {*} 10000, ref 0'
+	nrsTBFRuleRegexPattern = regexp.MustCompile(`^(\S+) (.*) (\d+), ref (-?\d+)$`)
+)
+
+type lustreProcessHistogramMetric struct {
+	pid string
+	lustreHistogramMetric
+}
+
+// lustreLabeledMetric is a metric carrying an arbitrary set of labels in addition to the component and target
+type lustreLabeledMetric struct {
 	lustreStatsMetric
+	labels      []string
+	labelValues []string
 }
 
-type lustreBRWMetric struct {
-	size      string
-	operation string
-	value     string
+type lustreQuotaUsage struct {
+	quotaType string
+	id        string
+	inodes    float64
+	kbytes    float64
+}
+
+// lustreQuotaUsageKey identifies the quota usage of an ID summed up over the targets of a filesystem
+type lustreQuotaUsageKey struct {
+	nodeType  string
+	fsName    string
+	quotaType string
+	id        string
+	promName  string
+	helpText  string
+}
+
+// lustrePoolKey identifies a metric of an OST pool, as the same pool is reported by the LOD of each
+// MDT and the LOV of each client mount of a filesystem
+type lustrePoolKey struct {
+	nodeType string
+	fsName   string
+	pool     string
+	promName string
+	ost      string
+}
+
+type lustreJobsMetric struct {
+	jobID string
+	lustreStatsMetric
 }
 
 type multistatParsingStruct struct {
@@ -112,8 +402,59 @@ type lustreProcFsSource struct {
 	basePath          string
 }
 
+// serviceMetricTemplates returns the templates shared by all PTLRPC services, such as 'ost_io' or 'ldlm_canceld'
+func serviceMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{"nrs_policies", "nrs_policy_started", nrsPolicyStartedHelp, gaugeMetric, false, core},
+		{"nrs_policies", "nrs_policy_queued_requests", nrsPolicyQueuedHelp, gaugeMetric, false, core},
+		{"nrs_policies", "nrs_policy_active_requests", nrsPolicyActiveHelp, gaugeMetric, false, core},
+		{"nrs_tbf_rule", "nrs_tbf_rule_rate", nrsTBFRuleRateHelp, gaugeMetric, false, core},
+		{"nrs_tbf_rule", "nrs_tbf_rule_references", nrsTBFRuleRefCountHelp, gaugeMetric, false, extended},
+		{"req_buffer_history_len", "service_request_history_length", "Number of request buffers kept in the history of the service", gaugeMetric, false, extended},
+		{"req_buffer_history_max", "service_request_history_maximum", "Maximum number of request buffers kept in the history of the service", gaugeMetric, false, extended},
+		{"stats", "service_requests_total", serviceRequestsHelp, counterMetric, false, core},
+		{"stats", "service_request_wait_time_microseconds_total", serviceWaitTimeHelp, counterMetric, false, core},
+		{"stats", "service_request_wait_time_maximum_microseconds", serviceWaitTimeMaximumHelp, gaugeMetric, false, extended},
+		{"stats", "service_request_queue_depth_sum_total", serviceQueueDepthHelp, counterMetric, false, extended},
+		{"stats", "service_request_queue_depth_maximum", serviceQueueDepthMaximumHelp, gaugeMetric, false, core},
+		{"stats", "service_active_requests_sum_total", serviceActiveHelp, counterMetric, false, extended},
+		{"stats", "service_active_requests_maximum", serviceActiveMaximumHelp, gaugeMetric, false, core},
+		{"stats", "service_request_timeout_maximum_seconds", serviceTimeoutMaximumHelp, gaugeMetric, false, extended},
+		{"timeouts", "service_at_estimate_seconds", serviceATEstimateHelp, gaugeMetric, false, core},
+		{"stats", "service_request_buffers_available_minimum", serviceBuffersMinimumHelp, gaugeMetric, false, core},
+		{"stats", "service_operations_total", serviceOperationsHelp, counterMetric, true, core},
+		{"stats", "service_operation_time_microseconds_total", serviceOperationTimeHelp, counterMetric, true, extended},
+		{"threads_max", "service_threads_maximum", "Maximum number of threads of the service", gaugeMetric, false, core},
+		{"threads_min", "service_threads_minimum", "Minimum number of threads of the service", gaugeMetric, false, extended},
+		{"threads_started", "service_threads_started", "Number of threads started by the service", gaugeMetric, false, core},
+	}
+}
+
+// importMetricTemplates returns the templates of the 'import' and 'timeouts' files shared by all
+// devices connecting to a target, such as OSCs, MDCs, OSPs or LWPs
+func importMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{"import", "import_state", importStateHelp, gaugeMetric, false, core},
+		{"import", "import_connection_info", importConnectionHelp, gaugeMetric, false, core},
+		{"import", "import_failover_nids", importFailoverNIDsHelp, gaugeMetric, false, extended},
+		{"import", "import_connection_attempts_total", importConnectionAttemptsHelp, counterMetric, false, core},
+		{"import", "import_invalidations_total", importInvalidationsHelp, counterMetric, false, core},
+		{"import", "import_rpcs_in_flight", importRPCsInFlightHelp, gaugeMetric, false, extended},
+		{"import", "import_rpc_timeouts_total", importRPCTimeoutsHelp, counterMetric, false, core},
+		{"import", "import_average_wait_time_microseconds", importAverageWaitTimeHelp, gaugeMetric, false, core},
+		{"import", "import_service_estimate_seconds", importServiceEstimateHelp, gaugeMetric, false, extended},
+		{"timeouts", "import_at_estimate_seconds", importATEstimateHelp, gaugeMetric, false, extended},
+		{"timeouts", "import_at_network_latency_seconds", importATNetworkLatencyHelp, gaugeMetric, false, extended},
+		{"import", "import_write_bytes_per_rpc", importWriteBytesPerRPCHelp, gaugeMetric, false, extended},
+		{"import", "import_write_microseconds_per_rpc", importWriteTimePerRPCHelp, gaugeMetric, false, extended},
+		{"import", "import_write_megabytes_per_second", importWriteThroughputHelp, gaugeMetric, false, extended},
+	}
+}
+
 func (s *lustreProcFsSource) generateOSTMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
+		ostServicesPath:  serviceMetricTemplates(),
+		"lwp/*-lwp-OST*": importMetricTemplates(),
 		"obdfilter/*-OST*": {
 			{"brw_size", "brw_size_megabytes", "Block read/write size in megabytes", gaugeMetric, false, extended},
 			{"grant_compat_disable", "grant_compat_disabled", "Binary indicator as to whether clients with OBD_CONNECT_GRANT_PARAM setting will be granted space", gaugeMetric, false, extended},
@@ -151,14 +492,18 @@ func (s *lustreProcFsSource) generateOSTMetricTemplates(filter string) {
 			{"exports/*@*/stats", "client_write_maximum_size_bytes", writeMaximumHelp, gaugeMetric, false, extended},
 			{"exports/*@*/stats", "client_write_bytes_total", writeTotalHelp, counterMetric, false, core},
 			{"exports/*@*/stats", "client_stats_total", statsHelp, counterMetric, true, core},
+			{"exports/*@*/ldlm_stats", "client_ldlm_stats_total", ldlmStatsHelp, counterMetric, true, core},
 		},
 		"osd-*/*-OST*": {
 			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", gaugeMetric, false, core},
-			{"brw_stats", "pages_per_bulk_rw_total", pagesPerBlockRWHelp, counterMetric, false, extended},
-			{"brw_stats", "discontiguous_pages_total", discontiguousPagesHelp, counterMetric, false, extended},
-			{"brw_stats", "disk_io", diskIOsInFlightHelp, gaugeMetric, false, core},
-			{"brw_stats", "io_time_milliseconds_total", ioTimeHelp, counterMetric, false, core},
-			{"brw_stats", "disk_io_total", diskIOSizeHelp, counterMetric, false, core},
+			// brw_stats blocks are exported as histograms, hence no metricFunc is required
+			{"brw_stats", "pages_per_bulk_rw", pagesPerBlockRWHelp, nil, false, extended},
+			{"brw_stats", "discontiguous_pages", discontiguousPagesHelp, nil, false, extended},
+			{"brw_stats", "discontiguous_blocks", discontiguousBlocksHelp, nil, false, extended},
+			{"brw_stats", "disk_fragmented_io", diskFragmentedIOsHelp, nil, false, extended},
+			{"brw_stats", "disk_io_in_flight", diskIOsInFlightHelp, nil, false, core},
+			{"brw_stats", "io_time_milliseconds", ioTimeHelp, nil, false, core},
+			{"brw_stats", "disk_io_size_bytes", diskIOSizeHelp, nil, false, core},
 			{"filesfree", "inodes_free", "The number of inodes (objects) available", gaugeMetric, false, core},
 			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gaugeMetric, false, core},
 			{"kbytesfree", "free_kilobytes", "Number of kilobytes free in the pool", gaugeMetric, false, core},
@@ -166,6 +511,11 @@ func (s *lustreProcFsSource) generateOSTMetricTemplates(filter string) {
 			{"kbytestotal", "capacity_kilobytes", "Capacity of the pool in kilobytes", gaugeMetric, false, core},
 		},
 	}
+	metricMap["obdfilter/*-OST*"] = append(metricMap["obdfilter/*-OST*"], recoveryMetricTemplates()...)
+	metricMap["obdfilter/*-OST*"] = append(metricMap["obdfilter/*-OST*"], siteStatsMetricTemplates()...)
+	metricMap["obdfilter/*-OST*"] = append(metricMap["obdfilter/*-OST*"], hashStatsMetricTemplates()...)
+	metricMap["obdfilter/*-OST*"] = append(metricMap["obdfilter/*-OST*"], lfsckMetricTemplates("lfsck_layout")...)
+	metricMap["osd-*/*-OST*"] = append(metricMap["osd-*/*-OST*"], oiScrubMetricTemplates()...)
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -176,6 +526,99 @@ func (s *lustreProcFsSource) generateOSTMetricTemplates(filter string) {
 	}
 }
 
+// lfsckMetricTemplates returns the templates of an LFSCK component, 'lfsck_layout' or 'lfsck_namespace'
+func lfsckMetricTemplates(filename string) []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{filename, "lfsck_status", lfsckStatusHelp, gaugeMetric, false, core},
+		{filename, "lfsck_success_total", lfsckSuccessHelp, counterMetric, false, core},
+		{filename, "lfsck_checked_objects", lfsckCheckedHelp, gaugeMetric, false, core},
+		{filename, "lfsck_failed_objects", lfsckFailedHelp, gaugeMetric, false, core},
+		{filename, "lfsck_repaired", lfsckRepairedHelp, gaugeMetric, false, core},
+		{filename, "lfsck_run_time_seconds", lfsckRunTimeHelp, gaugeMetric, false, extended},
+		{filename, "lfsck_average_speed_objects_per_second", lfsckSpeedHelp, gaugeMetric, false, extended},
+		{filename, "lfsck_time_since_last_completed_seconds", lfsckSinceCompletedHelp, gaugeMetric, false, core},
+	}
+}
+
+// oiScrubMetricTemplates returns the templates of the 'oi_scrub' file of an OSD
+func oiScrubMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{"oi_scrub", "oi_scrub_status", oiScrubStatusHelp, gaugeMetric, false, core},
+		{"oi_scrub", "oi_scrub_success_total", oiScrubSuccessHelp, counterMetric, false, core},
+		{"oi_scrub", "oi_scrub_checked_objects", oiScrubCheckedHelp, gaugeMetric, false, core},
+		{"oi_scrub", "oi_scrub_updated_objects", oiScrubUpdatedHelp, gaugeMetric, false, core},
+		{"oi_scrub", "oi_scrub_failed_objects", oiScrubFailedHelp, gaugeMetric, false, core},
+		{"oi_scrub", "oi_scrub_run_time_seconds", oiScrubRunTimeHelp, gaugeMetric, false, extended},
+		{"oi_scrub", "oi_scrub_average_speed_objects_per_second", oiScrubSpeedHelp, gaugeMetric, false, extended},
+		{"oi_scrub", "oi_scrub_time_since_last_completed_seconds", oiScrubSinceCompletedHelp, gaugeMetric, false, core},
+	}
+}
+
+// siteStatsMetricTemplates returns the templates of the 'site_stats' file of OSTs and MDTs
+func siteStatsMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{"site_stats", "site_busy_objects", siteBusyObjectsHelp, gaugeMetric, false, core},
+		{"site_stats", "site_objects", siteObjectsHelp, gaugeMetric, false, core},
+		{"site_stats", "site_hash_buckets_used", siteBucketsUsedHelp, gaugeMetric, false, extended},
+		{"site_stats", "site_hash_buckets", siteBucketsHelp, gaugeMetric, false, extended},
+		{"site_stats", "site_hash_maximum_search_depth", siteMaxSearchDepthHelp, gaugeMetric, false, extended},
+		{"site_stats", "site_objects_created_total", siteCreatedHelp, counterMetric, false, extended},
+		{"site_stats", "site_cache_hits_total", siteCacheHitsHelp, counterMetric, false, core},
+		{"site_stats", "site_cache_misses_total", siteCacheMissesHelp, counterMetric, false, core},
+		{"site_stats", "site_cache_races_total", siteCacheRacesHelp, counterMetric, false, extended},
+		{"site_stats", "site_cache_death_races_total", siteDeathRacesHelp, counterMetric, false, extended},
+		{"site_stats", "site_lru_purged_total", siteLRUPurgedHelp, counterMetric, false, core},
+	}
+}
+
+// hashStatsMetricTemplates returns the templates of the 'hash_stats' file of OSTs, MDTs and the MGS
+func hashStatsMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{"hash_stats", "hash_buckets", hashBucketsHelp, gaugeMetric, false, extended},
+		{"hash_stats", "hash_buckets_maximum", hashBucketsMaximumHelp, gaugeMetric, false, extended},
+		{"hash_stats", "hash_items", hashItemsHelp, gaugeMetric, false, core},
+		{"hash_stats", "hash_load_factor", hashLoadFactorHelp, gaugeMetric, false, extended},
+		{"hash_stats", "hash_rehashes_total", hashRehashesHelp, counterMetric, false, extended},
+		{"hash_stats", "hash_maximum_depth", hashMaxDepthHelp, gaugeMetric, false, extended},
+	}
+}
+
+// recoveryMetricTemplates returns the templates of the 'recovery_status' file of OSTs and MDTs
+func recoveryMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{"recovery_status", "recovery_status", recoveryStatusHelp, gaugeMetric, false, core},
+		{"recovery_status", "recovery_start_timestamp_seconds", recoveryStartHelp, gaugeMetric, false, core},
+		{"recovery_status", "recovery_duration_seconds", recoveryDurationHelp, gaugeMetric, false, core},
+		{"recovery_status", "recovery_time_remaining_seconds", recoveryTimeRemainingHelp, gaugeMetric, false, core},
+		{"recovery_status", "recovery_time_waited_seconds", recoveryTimeWaitedHelp, gaugeMetric, false, extended},
+		{"recovery_status", "recovery_completed_clients", recoveryCompletedClientsHelp, gaugeMetric, false, core},
+		{"recovery_status", "recovery_clients", recoveryClientsHelp, gaugeMetric, false, core},
+		{"recovery_status", "recovery_connected_clients", recoveryConnectedClientsHelp, gaugeMetric, false, core},
+		{"recovery_status", "recovery_request_replay_clients", recoveryReqReplayClientsHelp, gaugeMetric, false, extended},
+		{"recovery_status", "recovery_lock_replay_clients", recoveryLockReplayClientsHelp, gaugeMetric, false, extended},
+		{"recovery_status", "recovery_evicted_clients", recoveryEvictedClientsHelp, gaugeMetric, false, core},
+		{"recovery_status", "recovery_replayed_requests", recoveryReplayedRequestsHelp, gaugeMetric, false, extended},
+		{"recovery_status", "recovery_queued_requests", recoveryQueuedRequestsHelp, gaugeMetric, false, extended},
+	}
+}
+
+// hsmMetricTemplates returns the templates of the HSM coordinator of an MDT
+func hsmMetricTemplates() []lustreHelpStruct {
+	return []lustreHelpStruct{
+		{"hsm/actions", "hsm_actions", hsmActionsHelp, gaugeMetric, false, core},
+		{"hsm/active_requests", "hsm_active_requests", hsmActiveRequestsHelp, gaugeMetric, false, core},
+		{"hsm/max_requests", "hsm_max_requests", "Maximum number of HSM requests handled by the copytools at the same time", gaugeMetric, false, core},
+		{"hsm/agents", "hsm_agents", hsmAgentsHelp, gaugeMetric, false, core},
+		{"hsm/agents", "hsm_agent_current_requests", hsmAgentCurrentHelp, gaugeMetric, false, core},
+		{"hsm/agents", "hsm_agent_requests_total", hsmAgentRequestsHelp, counterMetric, false, core},
+		{"hsm/grace_delay", "hsm_grace_delay_seconds", "Time in seconds after which finished HSM actions are removed from the action list", gaugeMetric, false, extended},
+		{"hsm/active_request_timeout", "hsm_active_request_timeout_seconds", "Time in seconds after which active HSM requests time out", gaugeMetric, false, extended},
+		{"hsm/loop_period", "hsm_loop_period_seconds", "Time in seconds between two runs of the HSM coordinator", gaugeMetric, false, extended},
+		{"hsm/policy", "hsm_policy_enabled", hsmPolicyEnabledHelp, gaugeMetric, false, extended},
+		{"hsm_control", "hsm_coordinator_state", hsmCoordinatorStateHelp, gaugeMetric, false, core},
+	}
+}
+
 func (s *lustreProcFsSource) generateMDTMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
 		"osd-*/*-MDT*": {
@@ -186,13 +629,62 @@ func (s *lustreProcFsSource) generateMDTMetricTemplates(filter string) {
 			{"kbytesfree", "free_kilobytes", "Number of kilobytes free in the pool", gaugeMetric, false, core},
 			{"kbytestotal", "capacity_kilobytes", "Capacity of the pool in kilobytes", gaugeMetric, false, core},
 		},
+		"osp/*": {
+			{"active", "osp_active", "Returns '1' if the target is active for object allocation on this MDT", gaugeMetric, false, core},
+			{"blocksize", "osp_blocksize_bytes", "Filesystem block size in bytes of the target", gaugeMetric, false, extended},
+			{"create_count", "osp_create_count", "Number of objects precreated on the target by a single request", gaugeMetric, false, extended},
+			{"destroys_in_flight", "osp_destroys_in_flight", "Number of object destroy requests in flight to the target", gaugeMetric, false, core},
+			{"filesfree", "osp_inodes_free", "The number of inodes (objects) available on the target as seen by the MDT", gaugeMetric, false, extended},
+			{"filestotal", "osp_inodes_maximum", "The maximum number of inodes (objects) the target can hold as seen by the MDT", gaugeMetric, false, extended},
+			{"kbytesavail", "osp_available_kilobytes", "Number of kilobytes readily available on the target as seen by the MDT", gaugeMetric, false, extended},
+			{"kbytesfree", "osp_free_kilobytes", "Number of kilobytes free on the target as seen by the MDT", gaugeMetric, false, extended},
+			{"kbytestotal", "osp_capacity_kilobytes", "Capacity of the target in kilobytes as seen by the MDT", gaugeMetric, false, extended},
+			{"max_create_count", "osp_maximum_create_count", "Maximum number of objects precreated on the target by a single request, '0' disables object creation", gaugeMetric, false, core},
+			{"max_rpcs_in_flight", "osp_maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to the target", gaugeMetric, false, extended},
+			{"prealloc_last_id", "osp_prealloc_last_id", "Last object ID precreated on the target", gaugeMetric, false, extended},
+			{"prealloc_last_id", "osp_precreated_objects", precreatedObjectsHelp, gaugeMetric, false, core},
+			{"prealloc_next_id", "osp_prealloc_next_id", "Next precreated object ID to be used by the MDT", gaugeMetric, false, extended},
+			{"prealloc_reserved", "osp_prealloc_reserved_objects", "Number of precreated objects reserved for pending allocations", gaugeMetric, false, extended},
+			{"prealloc_status", "osp_prealloc_status", "Status of the object precreation, '0' if healthy, otherwise a negative error code such as -28 (ENOSPC)", gaugeMetric, false, core},
+			{"reserved_mb_high", "osp_reserved_high_megabytes", "Free space in megabytes above which object allocation on the target is resumed", gaugeMetric, false, extended},
+			{"reserved_mb_low", "osp_reserved_low_megabytes", "Free space in megabytes below which object allocation on the target is stopped", gaugeMetric, false, extended},
+			{"sync_changes", "osp_sync_changes", "Number of changes, such as object destroys, not yet synced to the target", gaugeMetric, false, core},
+			{"sync_in_flight", "osp_sync_in_flight", "Number of sync RPCs in flight to the target", gaugeMetric, false, core},
+			{"sync_in_progress", "osp_sync_in_progress", "Number of sync RPCs being processed by the target", gaugeMetric, false, extended},
+		},
+		"lwp/*-lwp-MDT*": importMetricTemplates(),
+		"lod/*": {
+			{"activeobd", "lod_active_targets", "Number of active OSTs in the LOD", gaugeMetric, false, core},
+			{"numobd", "lod_targets", "Number of OSTs in the LOD", gaugeMetric, false, core},
+			{"pools/*", "ost_pool_member", poolMemberHelp, gaugeMetric, false, core},
+			{"pools/*", "ost_pool_capacity_kilobytes", poolCapacityHelp, gaugeMetric, false, core},
+			{"pools/*", "ost_pool_free_kilobytes", poolFreeHelp, gaugeMetric, false, extended},
+			{"pools/*", "ost_pool_available_kilobytes", poolAvailableHelp, gaugeMetric, false, core},
+			{"qos_maxage", "lod_qos_maximum_age_seconds", "Maximum age in seconds of the statfs data used by the QoS allocator", gaugeMetric, false, extended},
+			{"qos_prio_free", "lod_qos_priority_free_percent", "Weight in percent given to free space by the QoS allocator", gaugeMetric, false, extended},
+			{"qos_threshold_rr", "lod_qos_threshold_rr_percent", "Free space imbalance in percent between OSTs above which the QoS allocator replaces the round-robin allocator", gaugeMetric, false, extended},
+			{"stripecount", "lod_default_stripe_count", "Default number of stripes of new files", gaugeMetric, false, core},
+			{"stripeoffset", "lod_default_stripe_offset", "Default index of the first OST of new files, '-1' lets the allocator choose", gaugeMetric, false, extended},
+			{"stripesize", "lod_default_stripe_size_bytes", "Default stripe size in bytes of new files", gaugeMetric, false, core},
+			{"target_obd", "lod_target_active", lodTargetActiveHelp, gaugeMetric, false, core},
+		},
 		"mdt/*": {
 			{mdStats, "stats_total", statsHelp, counterMetric, true, core},
 			{"num_exports", "exports_total", "Total number of times the pool has been exported", counterMetric, false, core},
+			// rename_stats blocks are exported as histograms, hence no metricFunc is required
+			{"rename_stats", "rename_directory_size_bytes", renameDirSizeHelp, nil, false, extended},
 			{"job_stats", "job_stats_total", jobStatsHelp, counterMetric, true, core},
 			{"exports/*@*/stats", "client_stats_total", statsHelp, counterMetric, true, core},
+			{"exports/*@*/ldlm_stats", "client_ldlm_stats_total", ldlmStatsHelp, counterMetric, true, core},
 		},
 	}
+	metricMap["osp/*"] = append(metricMap["osp/*"], importMetricTemplates()...)
+	metricMap["mdt/*"] = append(metricMap["mdt/*"], recoveryMetricTemplates()...)
+	metricMap["mdt/*"] = append(metricMap["mdt/*"], siteStatsMetricTemplates()...)
+	metricMap["mdt/*"] = append(metricMap["mdt/*"], hashStatsMetricTemplates()...)
+	metricMap["mdd/*"] = append(lfsckMetricTemplates("lfsck_layout"), lfsckMetricTemplates("lfsck_namespace")...)
+	metricMap["osd-*/*-MDT*"] = append(metricMap["osd-*/*-MDT*"], oiScrubMetricTemplates()...)
+	metricMap["mdt/*"] = append(metricMap["mdt/*"], hsmMetricTemplates()...)
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -213,7 +705,21 @@ func (s *lustreProcFsSource) generateMGSMetricTemplates(filter string) {
 			{"kbytesfree", "free_kilobytes", "Number of kilobytes free in the pool", gaugeMetric, false, core},
 			{"kbytestotal", "capacity_kilobytes", "Capacity of the pool in kilobytes", gaugeMetric, false, core},
 		},
+		"mgs/MGS": {
+			{"num_exports", "exports_total", "Total number of times the pool has been exported", counterMetric, false, core},
+			{"ir_timeout", "mgs_ir_timeout_seconds", "Time in seconds after which the MGS notifies the clients for imperative recovery of a restarted target", gaugeMetric, false, extended},
+			{"filesystems", "mgs_filesystems", mgsFilesystemsHelp, gaugeMetric, false, core},
+			{"live/*", "mgs_targets", mgsTargetsHelp, gaugeMetric, false, core},
+			{"live/*", "mgs_ir_state", mgsIRStateHelp, gaugeMetric, false, core},
+			{"live/*", "mgs_ir_nonir_clients", mgsIRNonIRClientsHelp, gaugeMetric, false, core},
+			{"live/*", "mgs_ir_nidtbl_version", mgsIRNidtblVersionHelp, gaugeMetric, false, extended},
+			{"live/*", "mgs_ir_notifications_total", mgsIRNotifyCountHelp, counterMetric, false, core},
+			{"live/*", "mgs_ir_notify_duration_seconds_total", mgsIRNotifyDurationHelp, counterMetric, false, extended},
+			{"live/*", "mgs_ir_notify_duration_maximum_seconds", mgsIRNotifyDurationMaxHelp, gaugeMetric, false, extended},
+		},
+		mgsServicesPath: serviceMetricTemplates(),
 	}
+	metricMap["mgs/MGS"] = append(metricMap["mgs/MGS"], hashStatsMetricTemplates()...)
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -225,7 +731,9 @@ func (s *lustreProcFsSource) generateMGSMetricTemplates(filter string) {
 }
 
 func (s *lustreProcFsSource) generateMDSMetricTemplates(filter string) {
-	metricMap := map[string][]lustreHelpStruct{}
+	metricMap := map[string][]lustreHelpStruct{
+		mdsServicesPath: serviceMetricTemplates(),
+	}
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -242,6 +750,8 @@ func (s *lustreProcFsSource) generateClientMetricTemplates(filter string) {
 			{"blocksize", "blocksize_bytes", "Filesystem block size in bytes", gaugeMetric, false, core},
 			{"checksum_pages", "checksum_pages_enabled", "Returns '1' if data checksumming is enabled for the client", gaugeMetric, false, extended},
 			{"default_easize", "default_ea_size_bytes", "Default Extended Attribute (EA) size in bytes", gaugeMetric, false, extended},
+			{"extents_stats", "extent_size_bytes", extentsSizeHelp, nil, false, extended},
+			{"extents_stats_per_process", "process_extent_size_bytes", processExtentsSizeHelp, nil, false, extended},
 			{"filesfree", "inodes_free", "The number of inodes (objects) available", gaugeMetric, false, core},
 			{"filestotal", "inodes_maximum", "The maximum number of inodes (objects) the filesystem can hold", gaugeMetric, false, core},
 			{"kbytesavail", "available_kilobytes", "Number of kilobytes readily available in the pool", gaugeMetric, false, core},
@@ -252,8 +762,28 @@ func (s *lustreProcFsSource) generateClientMetricTemplates(filter string) {
 			{"max_read_ahead_mb", "maximum_read_ahead_megabytes", "Maximum number of megabytes to read ahead", gaugeMetric, false, extended},
 			{"max_read_ahead_per_file_mb", "maximum_read_ahead_per_file_megabytes", "Maximum number of megabytes per file to read ahead", gaugeMetric, false, extended},
 			{"max_read_ahead_whole_mb", "maximum_read_ahead_whole_megabytes", "Maximum file size in megabytes for a file to be read in its entirety", gaugeMetric, false, extended},
+			{"offset_stats", "offset_range_size_bytes", offsetRangeSizeHelp, nil, false, extended},
+			{"read_ahead_stats", "read_ahead_hits_total", readAheadHitsHelp, counterMetric, false, core},
+			{"read_ahead_stats", "read_ahead_misses_total", readAheadMissesHelp, counterMetric, false, core},
+			{"read_ahead_stats", "read_ahead_readpage_not_consecutive_total", readAheadNotConsecutiveHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_miss_inside_window_total", readAheadMissInsideWindowHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_failed_grab_cache_page_total", readAheadFailedGrabCachePageHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_read_but_discarded_total", readAheadReadButDiscardedHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_zero_length_file_total", readAheadZeroLengthFileHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_zero_size_window_total", readAheadZeroSizeWindowHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_to_eof_total", readAheadToEOFHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_hit_max_issue_total", readAheadHitMaxIssueHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_wrong_range_total", readAheadWrongRangeHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_failed_to_reach_end_total", readAheadFailedToReachEndHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_failed_to_fast_read_total", readAheadFailedToFastReadHelp, counterMetric, false, extended},
+			{"read_ahead_stats", "read_ahead_async_total", readAheadAsyncHelp, counterMetric, false, extended},
 			{"statahead_agl", "statahead_agl_enabled", "Returns '1' if the Asynchronous Glimpse Lock (AGL) for statahead is enabled", gaugeMetric, false, extended},
 			{"statahead_max", "statahead_maximum", "Maximum window size for statahead", gaugeMetric, false, extended},
+			{"statahead_stats", "statahead_total", stataheadTotalHelp, counterMetric, false, extended},
+			{"statahead_stats", "statahead_wrong_total", stataheadWrongHelp, counterMetric, false, extended},
+			{"statahead_stats", "statahead_agl_total", stataheadAGLHelp, counterMetric, false, extended},
+			{"statahead_stats", "statahead_hits_total", stataheadHitsHelp, counterMetric, false, extended},
+			{"statahead_stats", "statahead_misses_total", stataheadMissesHelp, counterMetric, false, extended},
 			{"stats", "read_samples_total", readSamplesHelp, counterMetric, false, core},
 			{"stats", "read_minimum_size_bytes", readMinimumHelp, gaugeMetric, false, extended},
 			{"stats", "read_maximum_size_bytes", readMaximumHelp, gaugeMetric, false, extended},
@@ -265,15 +795,66 @@ func (s *lustreProcFsSource) generateClientMetricTemplates(filter string) {
 			{"stats", "stats_total", statsHelp, counterMetric, true, core},
 			{"xattr_cache", "xattr_cache_enabled", "Returns '1' if extended attribute cache is enabled", gaugeMetric, false, extended},
 		},
+		"lmv/*": {
+			{"activeobd", "lmv_active_targets", "Number of MDTs the mount considers active", gaugeMetric, false, core},
+			{"numobd", "lmv_targets", "Number of MDTs of the mount", gaugeMetric, false, core},
+			{"target_obd", "lmv_target_active", lmvTargetActiveHelp, gaugeMetric, false, core},
+		},
+		"lov/*-clilov-*": {
+			{"activeobd", "lov_active_targets", "Number of OSTs the mount considers active", gaugeMetric, false, core},
+			{"blocksize", "lov_blocksize_bytes", "Filesystem block size in bytes of the OSTs of the mount", gaugeMetric, false, extended},
+			{"filesfree", "lov_inodes_free", "The number of inodes (objects) available on the OSTs of the mount", gaugeMetric, false, extended},
+			{"filestotal", "lov_inodes_maximum", "The maximum number of inodes (objects) the OSTs of the mount can hold", gaugeMetric, false, extended},
+			{"kbytesavail", "lov_available_kilobytes", "Number of kilobytes readily available on the OSTs of the mount", gaugeMetric, false, extended},
+			{"kbytesfree", "lov_free_kilobytes", "Number of kilobytes free on the OSTs of the mount", gaugeMetric, false, extended},
+			{"kbytestotal", "lov_capacity_kilobytes", "Capacity of the OSTs of the mount in kilobytes", gaugeMetric, false, extended},
+			{"numobd", "lov_targets", "Number of OSTs of the mount", gaugeMetric, false, core},
+			{"stripecount", "lov_default_stripe_count", "Default number of stripes of new files", gaugeMetric, false, extended},
+			{"stripeoffset", "lov_default_stripe_offset", "Default index of the first OST of new files, '-1' lets the allocator choose", gaugeMetric, false, extended},
+			{"stripesize", "lov_default_stripe_size_bytes", "Default stripe size in bytes of new files", gaugeMetric, false, extended},
+			{"target_obd", "lov_target_active", lovTargetActiveHelp, gaugeMetric, false, core},
+			{"pools/*", "ost_pool_member", poolMemberHelp, gaugeMetric, false, core},
+			{"pools/*", "ost_pool_capacity_kilobytes", poolCapacityHelp, gaugeMetric, false, core},
+			{"pools/*", "ost_pool_free_kilobytes", poolFreeHelp, gaugeMetric, false, extended},
+			{"pools/*", "ost_pool_available_kilobytes", poolAvailableHelp, gaugeMetric, false, core},
+		},
 		"mdc/*": {
-			{"rpc_stats", "rpcs_in_flight", rpcsInFlightHelp, gaugeMetric, true, core},
+			{"rpc_stats", "current_rpcs_in_flight", currentRPCsInFlightHelp, gaugeMetric, false, core},
+			{"rpc_stats", "pending_pages", pendingPagesHelp, gaugeMetric, false, core},
+			{"rpc_stats", "pages_per_rpc", pagesPerRPCHelp, nil, false, extended},
+			{"rpc_stats", "rpcs_in_flight", rpcsInFlightHelp, nil, false, core},
+			{"rpc_stats", "rpcs_offset", offsetHelp, nil, false, extended},
+		},
+		clientOSCPath: {
+			{"checksum_type", "checksum_type_info", checksumTypeHelp, gaugeMetric, false, extended},
+			{"checksums", "checksums_enabled", "Returns '1' if data checksumming is enabled for this OST", gaugeMetric, false, extended},
+			{"cur_dirty_bytes", "dirty_bytes", "Number of bytes of dirty data held by the client for this OST", gaugeMetric, false, core},
+			{"cur_dirty_grant_bytes", "dirty_grant_bytes", "Number of bytes of dirty data covered by the grant of this OST", gaugeMetric, false, extended},
+			{"cur_grant_bytes", "grant_bytes", "Number of bytes of space granted by this OST to the client", gaugeMetric, false, core},
+			{"cur_lost_grant_bytes", "lost_grant_bytes", "Number of bytes of grant lost by the client for this OST", gaugeMetric, false, extended},
+			{"max_dirty_mb", "maximum_dirty_megabytes", "Maximum amount of dirty data in megabytes the client may hold for this OST", gaugeMetric, false, core},
+			{"max_pages_per_rpc", "maximum_pages_per_rpc", "Maximum number of pages per bulk RPC sent to this OST", gaugeMetric, false, extended},
+			{"max_rpcs_in_flight", "maximum_rpcs_in_flight", "Maximum number of concurrent RPCs in flight to this OST", gaugeMetric, false, core},
+			{"osc_cached_mb", "cached_megabytes", oscCachedHelp, gaugeMetric, false, extended},
+			{"osc_cached_mb", "cached_busy_pages", oscCachedBusyHelp, gaugeMetric, false, extended},
+			{"osc_cached_mb", "cached_reclaim_pages_total", oscCachedReclaimHelp, counterMetric, false, extended},
+			{"osc_stats", "lockless_write_bytes_total", locklessWriteBytesHelp, counterMetric, false, extended},
+			{"osc_stats", "lockless_read_bytes_total", locklessReadBytesHelp, counterMetric, false, extended},
+			{"osc_stats", "lockless_truncate_total", locklessTruncateHelp, counterMetric, false, extended},
+			{"resend_count", "resend_count", "Number of times a failed RPC is resent to this OST", gaugeMetric, false, extended},
+			{"unstable_stats", "unstable_pages", unstablePagesHelp, gaugeMetric, false, extended},
+			{"unstable_stats", "unstable_megabytes", unstableMegabytesHelp, gaugeMetric, false, extended},
 		},
 		"osc/*": {
-			{"rpc_stats", "pages_per_rpc_total", pagesPerRPCHelp, counterMetric, false, core},
-			{"rpc_stats", "rpcs_in_flight", rpcsInFlightHelp, gaugeMetric, true, core},
-			{"rpc_stats", "rpcs_offset", offsetHelp, gaugeMetric, false, core},
+			{"rpc_stats", "current_rpcs_in_flight", currentRPCsInFlightHelp, gaugeMetric, false, core},
+			{"rpc_stats", "pending_pages", pendingPagesHelp, gaugeMetric, false, core},
+			{"rpc_stats", "pages_per_rpc", pagesPerRPCHelp, nil, false, core},
+			{"rpc_stats", "rpcs_in_flight", rpcsInFlightHelp, nil, false, core},
+			{"rpc_stats", "rpcs_offset", offsetHelp, nil, false, core},
 		},
 	}
+	metricMap["mdc/*"] = append(metricMap["mdc/*"], importMetricTemplates()...)
+	metricMap[clientOSCPath] = append(metricMap[clientOSCPath], importMetricTemplates()...)
 	for path := range metricMap {
 		for _, item := range metricMap[path] {
 			if filter == extended || item.priorityLevel == core {
@@ -286,6 +867,7 @@ func (s *lustreProcFsSource) generateClientMetricTemplates(filter string) {
 
 func (s *lustreProcFsSource) generateGenericMetricTemplates(filter string) {
 	metricMap := map[string][]lustreHelpStruct{
+		ldlmServicesPath: serviceMetricTemplates(),
 		"sptlrpc": {
 			{"encrypt_page_pools", "physical_pages", physicalPagesHelp, gaugeMetric, false, extended},
 			{"encrypt_page_pools", "pages_per_pool", pagesPerPoolHelp, gaugeMetric, false, extended},
@@ -314,6 +896,44 @@ func (s *lustreProcFsSource) generateGenericMetricTemplates(filter string) {
 	}
 }
 
+func (s *lustreProcFsSource) generateQuotaMetricTemplates(filter string) {
+	metricMap := map[string][]lustreHelpStruct{
+		"osd-*/*": {
+			{quotaAccountingFiles, "quota_used_inodes", quotaUsedInodesHelp, gaugeMetric, false, core},
+			{quotaAccountingFiles, "quota_used_bytes", quotaUsedBytesHelp, gaugeMetric, false, core},
+			{quotaSlaveInfo, "quota_slave_enforced", quotaSlaveEnforcedHelp, gaugeMetric, false, core},
+			{quotaSlaveInfo, "quota_slave_accounting_enabled", quotaSlaveAccountingHelp, gaugeMetric, false, extended},
+			{quotaSlaveInfo, "quota_slave_master_connected", quotaSlaveConnectedHelp, gaugeMetric, false, core},
+			{quotaSlaveInfo, "quota_slave_global_index_uptodate", quotaSlaveGlobalUptodateHelp, gaugeMetric, false, extended},
+			{quotaSlaveInfo, "quota_slave_local_index_uptodate", quotaSlaveLocalUptodateHelp, gaugeMetric, false, extended},
+			{quotaSlaveInfo, "quota_slave_reintegration_running", quotaSlaveReintegrationHelp, gaugeMetric, false, extended},
+		},
+		"qmt/*": {
+			{qmtGlobalIndexFiles, "quota_hard_limit_bytes", qmtHardLimitBytesHelp, gaugeMetric, false, core},
+			{qmtGlobalIndexFiles, "quota_hard_limit_inodes", qmtHardLimitInodesHelp, gaugeMetric, false, core},
+			{qmtGlobalIndexFiles, "quota_soft_limit_bytes", qmtSoftLimitBytesHelp, gaugeMetric, false, core},
+			{qmtGlobalIndexFiles, "quota_soft_limit_inodes", qmtSoftLimitInodesHelp, gaugeMetric, false, core},
+			{qmtGlobalIndexFiles, "quota_granted_bytes", qmtGrantedBytesHelp, gaugeMetric, false, core},
+			{qmtGlobalIndexFiles, "quota_granted_inodes", qmtGrantedInodesHelp, gaugeMetric, false, core},
+			{qmtGlobalIndexFiles, "quota_granted_over_soft_limit", qmtGrantedOverSoftHelp, gaugeMetric, false, core},
+			{qmtGlobalIndexFiles, "quota_grace_expiry_timestamp_seconds", qmtGraceExpiryHelp, gaugeMetric, false, core},
+			{qmtGlobalIndexFiles, "quota_grace_period_seconds", qmtGracePeriodHelp, gaugeMetric, false, extended},
+			{qmtPoolInfo, "quota_pool_least_qunit", qmtLeastQunitHelp, gaugeMetric, false, extended},
+			{qmtSoftLeastQunit, "quota_pool_soft_least_qunit", qmtSoftLeastQunitHelp, gaugeMetric, false, extended},
+			{qmtPoolInfo, "quota_pool_slaves", qmtPoolSlavesHelp, gaugeMetric, false, extended},
+			{qmtPoolInfo, "quota_pool_entries", qmtPoolEntriesHelp, gaugeMetric, false, extended},
+		},
+	}
+	for path := range metricMap {
+		for _, item := range metricMap[path] {
+			if filter == extended || item.priorityLevel == core {
+				newMetric := newLustreProcMetric(item.filename, item.promName, "quota", path, item.helpText, item.hasMultipleVals, item.metricFunc)
+				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
+			}
+		}
+	}
+}
+
 func newLustreProcFsSource() LustreSource {
 	var l lustreProcFsSource
 	l.basePath = filepath.Join(ProcLocation, "fs/lustre")
@@ -336,12 +956,34 @@ func newLustreProcFsSource() LustreSource {
 	if GenericEnabled != disabled {
 		l.generateGenericMetricTemplates(GenericEnabled)
 	}
+	if QuotaEnabled != disabled {
+		l.generateQuotaMetricTemplates(QuotaEnabled)
+	}
 	return &l
 }
 
+// targetLabels returns the labels identifying the target of a metric. Client OSC devices,
+// e.g. 'lustrefs-OST0000-osc-ffff88105db50000', are labelled with the OST and the mount
+// instead of the device name.
+// isServicePath returns whether the metrics of the template path belong to a service rather than a target
+func isServicePath(path string) bool {
+	return path == ostServicesPath || path == mdsServicesPath || path == mgsServicesPath || path == ldlmServicesPath
+}
+
+func targetLabels(nodeType string, nodeName string) (labels []string, labelValues []string) {
+	if matched, _ := filepath.Match(filepath.Base(clientOSCPath), nodeName); matched {
+		ostName, mount := parseOSCDeviceName(nodeName)
+		return []string{"component", "target", "mount"}, []string{nodeType, ostName, mount}
+	}
+	return []string{"component", "target"}, []string{nodeType, nodeName}
+}
+
 func (s *lustreProcFsSource) Update(ch chan<- prometheus.Metric) (err error) {
 	var metricType string
 	var directoryDepth int
+	quotaUsage := map[lustreQuotaUsageKey]float64{}
+	pools := map[lustrePoolKey]bool{}
+	importFiles := map[string]lustreImport{}
 
 	for _, metric := range s.lustreProcMetrics {
 		directoryDepth = strings.Count(metric.filename, "/")
@@ -355,41 +997,222 @@ func (s *lustreProcFsSource) Update(ch chan<- prometheus.Metric) (err error) {
 		for _, path := range paths {
 			metricType = single
 			switch metric.filename {
-			case "brw_stats", "rpc_stats":
-				err = s.parseBRWStats(metric.source, "stats", path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, brwOperation string, brwSize string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+			case "brw_stats":
+				err = s.parseBRWHistograms(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, histogram lustreHistogramMetric) {
+					ch <- histogramMetric([]string{"component", "target", "operation"}, []string{nodeType, nodeName, histogram.operation}, name, helpText, histogram.count, histogram.buckets)
+				})
+				if err != nil {
+					return err
+				}
+			case "rename_stats":
+				err = s.parseRenameStats(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, histogram lustreHistogramMetric) {
+					ch <- histogramMetric([]string{"component", "target", "kind"}, []string{nodeType, nodeName, histogram.operation}, name, helpText, histogram.count, histogram.buckets)
+				})
+				if err != nil {
+					return err
+				}
+			case "rpc_stats":
+				// The device type (osc, mdc) is kept as label to distinguish the histograms of both
+				pathElements := strings.Split(path, "/")
+				deviceType := pathElements[len(pathElements)-3]
+				err = s.parseRPCStats(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+					labels, labelValues := targetLabels(nodeType, nodeName)
+					ch <- metric.metricFunc(append(labels, "type", extraLabel), append(labelValues, deviceType, extraLabelValue), name, helpText, value)
+				}, func(nodeType string, nodeName string, name string, helpText string, histogram lustreHistogramMetric) {
+					labels, labelValues := targetLabels(nodeType, nodeName)
+					ch <- histogramMetric(append(labels, "type", "operation"), append(labelValues, deviceType, histogram.operation), name, helpText, histogram.count, histogram.buckets)
+				})
+				if err != nil {
+					return err
+				}
+			case "extents_stats", "extents_stats_per_process", "offset_stats":
+				err = s.parseExtentsStats(metric.source, metric.filename, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, histogram lustreProcessHistogramMetric) {
+					if histogram.pid == "" {
+						ch <- histogramMetric([]string{"component", "target", "operation"}, []string{nodeType, nodeName, histogram.operation}, name, helpText, histogram.count, histogram.buckets)
+					} else {
+						ch <- histogramMetric([]string{"component", "target", "pid", "operation"}, []string{nodeType, nodeName, histogram.pid, histogram.operation}, name, helpText, histogram.count, histogram.buckets)
+					}
+				})
+				if err != nil {
+					return err
+				}
+			case "import":
+				err = s.parseImport(importFiles, metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+					labels, labelValues := targetLabels(nodeType, nodeName)
+					if extraLabelValue != "" {
+						labels = append(labels, extraLabel)
+						labelValues = append(labelValues, extraLabelValue)
+					}
+					ch <- metric.metricFunc(labels, labelValues, name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
+			case "pools/*":
+				err = s.parsePool(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, fsName string, pool string, name string, helpText string, value float64, ost string) {
+					key := lustrePoolKey{nodeType, fsName, pool, name, ost}
+					if pools[key] {
+						return
+					}
+					pools[key] = true
+					if ost == "" {
+						ch <- metric.metricFunc([]string{"component", "fsname", "pool"}, []string{nodeType, fsName, pool}, name, helpText, value)
+					} else {
+						ch <- metric.metricFunc([]string{"component", "fsname", "pool", "ost"}, []string{nodeType, fsName, pool, ost}, name, helpText, value)
+					}
+				})
+				if err != nil {
+					return err
+				}
+			case "nrs_policies", "nrs_tbf_rule":
+				err = s.parseNRS(metric.source, metric.filename, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, labels []string, labelValues []string) {
+					ch <- metric.metricFunc(append([]string{"component", "service"}, labels...), append([]string{nodeType, nodeName}, labelValues...), name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
+			case quotaAccountingFiles:
+				err = s.parseQuotaAccounting(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, quotaType string, id string) {
+					if QuotaAggregation != quotaPerFilesystem {
+						idLabels, idLabelValues, idValue := resolveQuotaID(quotaType, id)
+						ch <- metric.metricFunc(append([]string{"component", "target", "quota_type", "id"}, idLabels...), append([]string{nodeType, nodeName, quotaType, idValue}, idLabelValues...), name, helpText, value)
+						return
+					}
+					// The inodes accounted on the OSTs are objects, only the inodes of the MDTs count as files like in 'lfs quota'
+					if helpText == quotaUsedInodesHelp && !strings.Contains(nodeName, "-MDT") {
+						return
+					}
+					quotaUsage[lustreQuotaUsageKey{nodeType, parseTargetFsName(nodeName), quotaType, id, name, helpText}] += value
+				})
+				if err != nil {
+					return err
+				}
+			case quotaSlaveInfo:
+				err = s.parseQuotaSlaveInfo(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
 					if extraLabelValue == "" {
-						ch <- metric.metricFunc([]string{"component", "target", "operation", "size"}, []string{nodeType, nodeName, brwOperation, brwSize}, name, helpText, value)
+						ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
 					} else {
-						ch <- metric.metricFunc([]string{"component", "target", "operation", "size", extraLabel}, []string{nodeType, nodeName, brwOperation, brwSize, extraLabelValue}, name, helpText, value)
+						ch <- metric.metricFunc([]string{"component", "target", extraLabel}, []string{nodeType, nodeName, extraLabelValue}, name, helpText, value)
 					}
 				})
 				if err != nil {
 					return err
 				}
-			case "job_stats":
-				err = s.parseJobStats(metric.source, "job_stats", path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, jobid string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+			case qmtGlobalIndexFiles, qmtPoolInfo, qmtSoftLeastQunit:
+				err = s.parseQMT(metric.source, metric.filename, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, labels []string, labelValues []string) {
+					ch <- metric.metricFunc(append([]string{"component", "target"}, labels...), append([]string{nodeType, nodeName}, labelValues...), name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
+			case "hsm/actions", "hsm/active_requests", "hsm/agents", "hsm/policy", "hsm_control":
+				err = s.parseHSM(metric.source, metric.filename, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, labels []string, labelValues []string) {
+					ch <- metric.metricFunc(append([]string{"component", "target"}, labels...), append([]string{nodeType, nodeName}, labelValues...), name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
+			case "filesystems", "live/*":
+				err = s.parseMGS(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, fsName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+					labels := []string{"component", "target"}
+					labelValues := []string{nodeType, nodeName}
+					if fsName != "" {
+						labels = append(labels, "fsname")
+						labelValues = append(labelValues, fsName)
+					}
+					if extraLabelValue != "" {
+						labels = append(labels, extraLabel)
+						labelValues = append(labelValues, extraLabelValue)
+					}
+					ch <- metric.metricFunc(labels, labelValues, name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
+			case "recovery_status":
+				err = s.parseRecoveryStatus(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+					if extraLabelValue == "" {
+						ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
+					} else {
+						ch <- metric.metricFunc([]string{"component", "target", extraLabel}, []string{nodeType, nodeName, extraLabelValue}, name, helpText, value)
+					}
+				})
+				if err != nil {
+					return err
+				}
+			case "site_stats", "hash_stats":
+				err = s.parseLuSite(metric.source, metric.filename, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
 					if extraLabelValue == "" {
-						ch <- metric.metricFunc([]string{"component", "target", "jobid"}, []string{nodeType, nodeName, jobid}, name, helpText, value)
+						ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
 					} else {
-						ch <- metric.metricFunc([]string{"component", "target", "jobid", extraLabel}, []string{nodeType, nodeName, jobid, extraLabelValue}, name, helpText, value)
+						ch <- metric.metricFunc([]string{"component", "target", extraLabel}, []string{nodeType, nodeName, extraLabelValue}, name, helpText, value)
+					}
+				})
+				if err != nil {
+					return err
+				}
+			case "timeouts":
+				isService := isServicePath(metric.path)
+				err = s.parseATTimeouts(metric.source, path, directoryDepth, isService, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, labels []string, labelValues []string) {
+					prefixLabels, prefixLabelValues := targetLabels(nodeType, nodeName)
+					if isService {
+						prefixLabels = []string{"component", "service"}
+					}
+					ch <- metric.metricFunc(append(prefixLabels, labels...), append(prefixLabelValues, labelValues...), name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
+			case "lfsck_layout", "lfsck_namespace", "oi_scrub":
+				err = s.parseLFSCK(metric.source, path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64, labels []string, labelValues []string) {
+					ch <- metric.metricFunc(append([]string{"component", "target"}, labels...), append([]string{nodeType, nodeName}, labelValues...), name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
+			case "job_stats":
+				err = s.parseJobStats(metric.source, "job_stats", path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, jobid string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+					jobLabels, jobLabelValues, jobidValue := resolveJobID(jobid)
+					labels := append([]string{"component", "target", "jobid"}, jobLabels...)
+					labelValues := append([]string{nodeType, nodeName, jobidValue}, jobLabelValues...)
+					if extraLabelValue != "" {
+						labels = append(labels, extraLabel)
+						labelValues = append(labelValues, extraLabelValue)
 					}
+					ch <- metric.metricFunc(labels, labelValues, name, helpText, value)
 				})
 				if err != nil {
 					return err
 				}
 			default:
 				var clientIP string
-				if metric.filename == stats {
+				isService := isServicePath(metric.path)
+				if metric.filename == stats && isService {
+					metricType = serviceStats
+				} else if metric.filename == stats {
 					metricType = stats
 				} else if metric.filename == mdStats {
 					metricType = mdStats
 				} else if metric.filename == encryptPagePools {
 					metricType = encryptPagePools
+				} else if metric.filename == targetObd {
+					metricType = targetObd
+				} else if metric.path == "lod/*" || metric.path == "lov/*-clilov-*" {
+					metricType = lod
+				} else if metric.helpText == precreatedObjectsHelp {
+					metricType = precreated
+				} else if metric.filename == readAheadStats || metric.filename == stataheadStats ||
+					metric.filename == oscCachedMB || metric.filename == oscStats || metric.filename == unstableStats ||
+					metric.filename == checksumType {
+					metricType = metric.filename
 				} else if strings.HasPrefix(metric.filename, "exports/") {
 					metricType = stats
 					if metric.source == "mdt" {
 						metricType = mdStats
 					}
+					if strings.HasSuffix(metric.filename, "/"+ldlmStats) {
+						metricType = ldlmStats
+					}
 					clientIP, err = parseClientIP(path)
 					if err != nil {
 						return err
@@ -400,8 +1223,10 @@ func (s *lustreProcFsSource) Update(ch chan<- prometheus.Metric) (err error) {
 					}
 				}
 				err = s.parseFile(metric.source, metricType, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
-					labels := []string{"component", "target"}
-					labelValues := []string{nodeType, nodeName}
+					labels, labelValues := targetLabels(nodeType, nodeName)
+					if isService {
+						labels = []string{"component", "service"}
+					}
 					if len(clientIP) != 0 {
 						labels = append(labels, "client")
 						labelValues = append(labelValues, clientIP)
@@ -418,6 +1243,10 @@ func (s *lustreProcFsSource) Update(ch chan<- prometheus.Metric) (err error) {
 			}
 		}
 	}
+	for key, value := range quotaUsage {
+		idLabels, idLabelValues, idValue := resolveQuotaID(key.quotaType, key.id)
+		ch <- gaugeMetric(append([]string{"component", "fsname", "quota_type", "id"}, idLabels...), append([]string{key.nodeType, key.fsName, key.quotaType, idValue}, idLabelValues...), key.promName, key.helpText, value)
+	}
 	return nil
 }
 
@@ -494,13 +1323,50 @@ func getStatsIOMetrics(statsFile string, promName string, helpText string) (metr
 		lowFreeMarkHelp:       {pattern: "low free mark: .*", index: 3},
 		maxWaitQueueDepthHelp: {pattern: "max waitqueue depth: .*", index: 3},
 		outOfMemHelp:          {pattern: "out of mem: .*", index: 3},
+		// read_ahead_stats lines are in the format: {name} {number of samples} 'samples' [{units}]
+		readAheadHitsHelp:                {pattern: "hits .*", index: 1},
+		readAheadMissesHelp:              {pattern: "misses .*", index: 1},
+		readAheadNotConsecutiveHelp:      {pattern: "readpage not consecutive .*", index: 3},
+		readAheadMissInsideWindowHelp:    {pattern: "miss inside window .*", index: 3},
+		readAheadFailedGrabCachePageHelp: {pattern: "failed grab_cache_page .*", index: 2},
+		readAheadReadButDiscardedHelp:    {pattern: "read but discarded .*", index: 3},
+		readAheadZeroLengthFileHelp:      {pattern: "zero length file .*", index: 3},
+		readAheadZeroSizeWindowHelp:      {pattern: "zero size window .*", index: 3},
+		readAheadToEOFHelp:               {pattern: "read-ahead to EOF .*", index: 3},
+		readAheadHitMaxIssueHelp:         {pattern: "hit max r-a issue .*", index: 4},
+		readAheadWrongRangeHelp:          {pattern: "wrong range .*", index: 2},
+		readAheadFailedToReachEndHelp:    {pattern: "failed to reach end .*", index: 4},
+		readAheadFailedToFastReadHelp:    {pattern: "failed to fast read .*", index: 4},
+		readAheadAsyncHelp:               {pattern: "async readahead .*", index: 2},
+		oscCachedHelp:                    {pattern: "used_mb: .*", index: 1},
+		oscCachedBusyHelp:                {pattern: "busy_cnt: .*", index: 1},
+		oscCachedReclaimHelp:             {pattern: "reclaim: .*", index: 1},
+		locklessWriteBytesHelp:           {pattern: "lockless_write_bytes.*", index: 1},
+		locklessReadBytesHelp:            {pattern: "lockless_read_bytes.*", index: 1},
+		locklessTruncateHelp:             {pattern: "lockless_truncate.*", index: 1},
+		unstablePagesHelp:                {pattern: "unstable_pages: .*", index: 1},
+		unstableMegabytesHelp:            {pattern: "unstable_mb: .*", index: 1},
+		serviceRequestsHelp:              {pattern: "req_waittime .*", index: 1},
+		serviceWaitTimeHelp:              {pattern: "req_waittime .*", index: 6},
+		serviceWaitTimeMaximumHelp:       {pattern: "req_waittime .*", index: 5},
+		serviceQueueDepthHelp:            {pattern: "req_qdepth .*", index: 6},
+		serviceQueueDepthMaximumHelp:     {pattern: "req_qdepth .*", index: 5},
+		serviceActiveHelp:                {pattern: "req_active .*", index: 6},
+		serviceActiveMaximumHelp:         {pattern: "req_active .*", index: 5},
+		serviceTimeoutMaximumHelp:        {pattern: "req_timeout .*", index: 5},
+		serviceBuffersMinimumHelp:        {pattern: "reqbuf_avail .*", index: 4},
+		stataheadTotalHelp:               {pattern: "statahead total: .*", index: 2},
+		stataheadWrongHelp:               {pattern: "statahead wrong: .*", index: 2},
+		stataheadAGLHelp:                 {pattern: "agl total: .*", index: 2},
+		stataheadHitsHelp:                {pattern: "hit_total: .*", index: 1},
+		stataheadMissesHelp:              {pattern: "miss_total: .*", index: 1},
 	}
 	pattern := bytesMap[helpText].pattern
 	bytesString := regexCaptureString(pattern, statsFile)
 	if len(bytesString) < 1 {
 		return nil, nil
 	}
-	r, err := regexp.Compile(" +")
+	r, err := regexp.Compile("[ \t]+")
 	if err != nil {
 		return nil, err
 	}
@@ -514,215 +1380,2050 @@ func getStatsIOMetrics(statsFile string, promName string, helpText string) (metr
 	return metricList, nil
 }
 
-func splitBRWStats(statBlock string) (metricList []lustreBRWMetric, err error) {
-	if len(statBlock) == 0 || statBlock == "" {
+func addHistogramBucket(histogram *lustreHistogramMetric, bound float64, count uint64) {
+	// Rows are sorted in ascending order, so the running count is the cumulative bucket value.
+	histogram.count += count
+	histogram.buckets[bound] = histogram.count
+}
+
+func splitHistogramBlock(statBlock string, operations []string) (metricList []lustreHistogramMetric, err error) {
+	if len(statBlock) == 0 {
 		return nil, nil
 	}
 
+	for _, operation := range operations {
+		metricList = append(metricList, lustreHistogramMetric{operation: operation, buckets: map[float64]uint64{}})
+	}
+
 	// Skip the first line of text as it doesn't contain any metrics
 	for _, line := range strings.Split(statBlock, "\n")[1:] {
-		if len(line) > 1 {
-			fields := strings.Fields(line)
-			// Lines are in the following format:
-			// [size] [# read RPCs] [relative read size (%)] [cumulative read size (%)] | [# write RPCs] [relative write size (%)] [cumulative write size (%)]
-			// [0]    [1]           [2]                      [3]                       [4] [5]           [6]                       [7]
-			if len(fields) >= 6 {
-				size, readRPCs, writeRPCs := fields[0], fields[1], fields[5]
-				size = strings.Replace(size, ":", "", -1)
-				metricList = append(metricList, lustreBRWMetric{size: size, operation: "read", value: readRPCs})
-				metricList = append(metricList, lustreBRWMetric{size: size, operation: "write", value: writeRPCs})
-			} else if len(fields) >= 1 {
-				size, rpcs := fields[0], fields[1]
-				size = strings.Replace(size, ":", "", -1)
-				metricList = append(metricList, lustreBRWMetric{size: size, operation: "read", value: rpcs})
-			} else {
-				continue
+		fields := strings.Fields(line)
+		// Lines are in the following format, with one column group per operation:
+		// [bucket] [# read] [relative read (%)] [cumulative read (%)] | [# write] [relative write (%)] [cumulative write (%)]
+		// [0]      [1]      [2]                 [3]                  [4] [5]      [6]                  [7]
+		if len(fields) < 4*len(operations) {
+			continue
+		}
+		bound, err := convertToBucketBound(fields[0])
+		if err != nil {
+			return nil, err
+		}
+		for i := range metricList {
+			count, err := strconv.ParseUint(fields[1+4*i], 10, 64)
+			if err != nil {
+				return nil, err
 			}
+			addHistogramBucket(&metricList[i], bound, count)
 		}
 	}
+	if len(metricList) == 0 || len(metricList[0].buckets) == 0 {
+		return nil, nil
+	}
 	return metricList, nil
 }
 
-func parseStatsFile(helpText string, promName string, path string, hasMultipleVals bool) (metricList []lustreStatsMetric, err error) {
-	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
-	if err != nil {
-		return nil, err
+func splitBRWHistograms(statBlock string) (metricList []lustreHistogramMetric, err error) {
+	return splitHistogramBlock(statBlock, []string{"read", "write"})
+}
+
+// renameKinds maps the blocks of the 'rename_stats' file to the 'kind' label of the histograms
+var renameKinds = map[string]string{
+	"same_dir":     "samedir",
+	"crossdir_src": "crossdir_src",
+	"crossdir_tgt": "crossdir_tgt",
+}
+
+// parseRenameStatsText parses the 'rename_stats' file of an MDT, which lists the number of renames per
+// directory size for renames within a directory and for the source and target directories of other renames:
+// rename_stats:
+//   - snapshot_time:  1510781853.010473844
+//   - same_dir:
+//     4KB: { sample:   9, pct:  64, cum_pct:  64 }
+//     8KB: { sample:   3, pct:  21, cum_pct:  85 }
+//   - crossdir_src:
+//     4KB: { sample:   2, pct: 100, cum_pct: 100 }
+//
+// The 'kind' of the histograms is kept in their operation.
+func parseRenameStatsText(statsFile string) (metricList []lustreHistogramMetric, err error) {
+	var histogram *lustreHistogramMetric
+	for _, line := range strings.Split(statsFile, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && fields[0] == "-" {
+			histogram = nil
+			if kind, ok := renameKinds[strings.TrimSuffix(fields[1], ":")]; ok {
+				metricList = append(metricList, lustreHistogramMetric{operation: kind, buckets: map[float64]uint64{}})
+				histogram = &metricList[len(metricList)-1]
+			}
+			continue
+		}
+		if histogram == nil || len(fields) < 4 || fields[2] != "sample:" {
+			continue
+		}
+		// Directory sizes are given as e.g. '512bytes:', '4KB:' or '1MB:'
+		bucket := strings.TrimSuffix(strings.TrimSuffix(fields[0], ":"), "bytes")
+		bound, err := convertToBucketBound(strings.TrimSuffix(bucket, "B"))
+		if err != nil {
+			return nil, err
+		}
+		count, err := strconv.ParseUint(strings.TrimSuffix(fields[3], ","), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		addHistogramBucket(histogram, bound, count)
 	}
-	statsFile := string(statsFileBytes[:])
-	var statsList []lustreStatsMetric
-	if hasMultipleVals {
-		statsList, err = getStatsOperationMetrics(statsFile, promName, helpText)
-	} else {
-		statsList, err = getStatsIOMetrics(statsFile, promName, helpText)
+	return metricList, nil
+}
+
+func parseRPCStatsText(statsFile string, helpText string, promName string) (metricList []lustreStatsMetric, histogramList []lustreHistogramMetric, err error) {
+	// The header of the file holds the current values, e.g. 'write RPCs in flight: 6' or 'modify_RPCs_in_flight: 0'
+	headerPatterns := map[string]*regexp.Regexp{
+		currentRPCsInFlightHelp: rpcsInFlightRegexPattern,
+		pendingPagesHelp:        pendingPagesRegexPattern,
 	}
-	if err != nil {
-		return nil, err
+	if pattern, exists := headerPatterns[helpText]; exists {
+		for _, match := range pattern.FindAllStringSubmatch(statsFile, -1) {
+			value, err := strconv.ParseFloat(match[2], 64)
+			if err != nil {
+				return nil, nil, err
+			}
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "operation", match[1]))
+		}
+		return metricList, nil, nil
 	}
-	if statsList != nil {
-		metricList = append(metricList, statsList...)
+
+	metricBlocks := map[string]string{
+		pagesPerRPCHelp:  "pages per rpc",
+		rpcsInFlightHelp: "rpcs in flight",
+		offsetHelp:       "offset",
+	}
+	title, exists := metricBlocks[helpText]
+	if !exists {
+		return nil, nil, nil
+	}
+	// Each block starts with a line naming the operations of the columns ('read write' for OSCs and
+	// newer MDCs, 'modify' for the MDC modify RPCs), followed by the title line and the buckets.
+	for _, block := range strings.Split(statsFile, "\n\n") {
+		lines := strings.Split(strings.Trim(block, "\n"), "\n")
+		for i, line := range lines {
+			if !strings.HasPrefix(line, title) {
+				continue
+			}
+			operations := []string{"read", "write"}
+			if i > 0 {
+				operations = strings.Fields(lines[i-1])
+			}
+			histograms, err := splitHistogramBlock(strings.Join(lines[i:], "\n"), operations)
+			if err != nil {
+				return nil, nil, err
+			}
+			histogramList = append(histogramList, histograms...)
+			break
+		}
 	}
+	return nil, histogramList, nil
+}
 
-	return metricList, nil
+func newReadWriteHistograms() []lustreHistogramMetric {
+	return []lustreHistogramMetric{
+		{operation: "read", buckets: map[float64]uint64{}},
+		{operation: "write", buckets: map[float64]uint64{}},
+	}
 }
 
-func getJobStatsIOMetrics(jobBlock string, jobID string, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
-	// opMap matches the given helpText value with the placement of the numeric fields within each metric line.
-	// For example, the number of samples is the first number in the line and has a helpText of readSamplesHelp,
-	// hence the 'index' value of 0. 'pattern' is the regex capture pattern for the desired line.
-	opMap := map[string]multistatParsingStruct{
-		readSamplesHelp:  {index: 0, pattern: "read_bytes"},
-		readMinimumHelp:  {index: 1, pattern: "read_bytes"},
-		readMaximumHelp:  {index: 2, pattern: "read_bytes"},
-		readTotalHelp:    {index: 3, pattern: "read_bytes"},
+func splitExtentsRows(lines []string) (metricList []lustreHistogramMetric, err error) {
+	metricList = newReadWriteHistograms()
+	for _, line := range lines {
+		match := extentsRegexPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		// A row counts the extents of at least 'lo' and less than 'hi' bytes, so the
+		// inclusive upper bound of the bucket is one byte below the start of the next row
+		bound, err := convertToBucketBound(match[1])
+		if err != nil {
+			return nil, err
+		}
+		bound--
+		for i, value := range match[2:] {
+			count, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			addHistogramBucket(&metricList[i], bound, count)
+		}
+	}
+	if len(metricList[0].buckets) == 0 {
+		return nil, nil
+	}
+	return metricList, nil
+}
+
+func parseExtentsStatsText(statsFile string) (metricList []lustreHistogramMetric, err error) {
+	// When the stats are disabled, the file only contains a note on how to enable them
+	// and splitExtentsRows doesn't find any rows.
+	return splitExtentsRows(strings.Split(statsFile, "\n"))
+}
+
+func parseExtentsPerProcessText(statsFile string, topProcesses int) (metricList []lustreProcessHistogramMetric, err error) {
+	if topProcesses < 1 {
+		return nil, nil
+	}
+	// Each process block starts with a 'PID: {pid}' line followed by the extent rows of the process
+	var pids []string
+	blocks := map[string][]string{}
+	pid := ""
+	for _, line := range strings.Split(statsFile, "\n") {
+		if match := pidRegexPattern.FindStringSubmatch(line); match != nil {
+			pid = match[1]
+			pids = append(pids, pid)
+			continue
+		}
+		if pid != "" {
+			blocks[pid] = append(blocks[pid], line)
+		}
+	}
+	for _, pid := range pids {
+		histograms, err := splitExtentsRows(blocks[pid])
+		if err != nil {
+			return nil, err
+		}
+		for _, histogram := range histograms {
+			metricList = append(metricList, lustreProcessHistogramMetric{pid: pid, lustreHistogramMetric: histogram})
+		}
+	}
+
+	// Only keep the processes with the most read and write calls
+	calls := map[string]uint64{}
+	for _, metric := range metricList {
+		calls[metric.pid] += metric.count
+	}
+	if len(calls) <= topProcesses {
+		return metricList, nil
+	}
+	ranking := make([]string, 0, len(calls))
+	for pid := range calls {
+		ranking = append(ranking, pid)
+	}
+	sort.Slice(ranking, func(i, j int) bool {
+		if calls[ranking[i]] != calls[ranking[j]] {
+			return calls[ranking[i]] > calls[ranking[j]]
+		}
+		return ranking[i] < ranking[j]
+	})
+	topPids := map[string]bool{}
+	for _, pid := range ranking[:topProcesses] {
+		topPids[pid] = true
+	}
+	var topList []lustreProcessHistogramMetric
+	for _, metric := range metricList {
+		if topPids[metric.pid] {
+			topList = append(topList, metric)
+		}
+	}
+	return topList, nil
+}
+
+func parseOffsetStatsText(statsFile string) (metricList []lustreHistogramMetric, err error) {
+	// Sizes of the ranges are rounded up to the next power of two, as done for the extents histograms
+	counts := []map[float64]uint64{{}, {}}
+	for _, line := range strings.Split(statsFile, "\n") {
+		// Lines are in the following format:
+		// [R/W] [PID] [range start] [range end] [smallest extent] [largest extent] [offset]
+		// [0]   [1]   [2]           [3]         [4]               [5]              [6]
+		fields := strings.Fields(line)
+		if len(fields) != 7 || (fields[0] != "R" && fields[0] != "W") {
+			continue
+		}
+		start, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		end, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		bound := 1.0
+		for bound < float64(end-start) {
+			bound *= 2
+		}
+		if fields[0] == "R" {
+			counts[0][bound]++
+		} else {
+			counts[1][bound]++
+		}
+	}
+	if len(counts[0]) == 0 && len(counts[1]) == 0 {
+		return nil, nil
+	}
+
+	// Both histograms share the same buckets, which have to be added in ascending order
+	var bounds []float64
+	for bound := range counts[0] {
+		bounds = append(bounds, bound)
+	}
+	for bound := range counts[1] {
+		if _, exists := counts[0][bound]; !exists {
+			bounds = append(bounds, bound)
+		}
+	}
+	sort.Float64s(bounds)
+	metricList = newReadWriteHistograms()
+	for _, bound := range bounds {
+		for i := range metricList {
+			addHistogramBucket(&metricList[i], bound, counts[i][bound])
+		}
+	}
+	return metricList, nil
+}
+
+type lustreImport struct {
+	Import struct {
+		State      string `yaml:"state"`
+		Connection struct {
+			FailoverNIDs       []string `yaml:"failover_nids"`
+			CurrentConnection  string   `yaml:"current_connection"`
+			ConnectionAttempts float64  `yaml:"connection_attempts"`
+			Generation         float64  `yaml:"generation"`
+		} `yaml:"connection"`
+		RPCs struct {
+			InFlight    float64 `yaml:"inflight"`
+			Timeouts    float64 `yaml:"timeouts"`
+			AvgWaittime string  `yaml:"avg_waittime"`
+		} `yaml:"rpcs"`
+		ServiceEstimates  map[string]string `yaml:"service_estimates"`
+		WriteDataAverages struct {
+			BytesPerRPC *float64 `yaml:"bytes_per_rpc"`
+			UsecPerRPC  *float64 `yaml:"usec_per_rpc"`
+			MBPerSec    *float64 `yaml:"MB_per_sec"`
+		} `yaml:"write_data_averages"`
+	} `yaml:"import"`
+}
+
+// hsmCoordinatorStates lists the states of the HSM coordinator as shown by 'hsm_control', see 'cdt_mode2str' in Lustre
+var hsmCoordinatorStates = []string{"init", "enabled", "stopping", "stopped", "disabled"}
+
+// lfsckStates lists the states of an LFSCK component, see 'lfsck_status_names' in Lustre
+var lfsckStates = []string{"init", "scanning-phase1", "scanning-phase2", "completed", "failed", "stopped", "paused", "crashed", "partial", "co-failed", "co-stopped", "co-paused"}
+
+// oiScrubStates lists the states of the OI scrub, see 'scrub_status_names' in Lustre
+var oiScrubStates = []string{"init", "scanning", "completed", "failed", "stopped", "paused", "crashed"}
+
+// recoveryStates lists the states of a target recovery as shown by 'recovery_status', see 'lprocfs_recovery_status_seq_show' in Lustre
+var recoveryStates = []string{"INACTIVE", "WAITING", "RECOVERING", "COMPLETE"}
+
+// mgsIRStates lists the states of the imperative recovery of a filesystem, see 'ir_strings' in Lustre
+var mgsIRStates = []string{"disabled", "full", "partial", "startup"}
+
+// importStates lists the states an import can be in, see 'enum lustre_imp_state' in Lustre
+var importStates = []string{"CLOSED", "NEW", "DISCONN", "CONNECTING", "REPLAY", "REPLAY_LOCKS", "REPLAY_WAIT", "RECOVER", "FULL", "EVICTED", "IDLE"}
+
+// parseLeadingNumber parses values with a trailing unit such as '6054 usec' or '1 sec'
+func parseLeadingNumber(s string) (float64, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 1 {
+		return 0, fmt.Errorf("no value found in %q", s)
+	}
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+func parseImportText(importFile string) (imp lustreImport, err error) {
+	err = yaml.Unmarshal([]byte(importFile), &imp)
+	return imp, err
+}
+
+func getImportMetrics(imp lustreImport, helpText string, promName string) (metricList []lustreStatsMetric, err error) {
+	i := imp.Import
+	if i.State == "" {
+		return nil, nil
+	}
+	switch helpText {
+	case importStateHelp:
+		for _, state := range importStates {
+			value := 0.0
+			if state == i.State {
+				value = 1
+			}
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "state", state))
+		}
+	case importConnectionHelp:
+		if i.Connection.CurrentConnection != "" {
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, 1, "nid", i.Connection.CurrentConnection))
+		}
+	case importFailoverNIDsHelp:
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, float64(len(i.Connection.FailoverNIDs)), "", ""))
+	case importConnectionAttemptsHelp:
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, i.Connection.ConnectionAttempts, "", ""))
+	case importInvalidationsHelp:
+		// The generation is increased once when the import is set up and on every invalidation afterwards,
+		// see 'ptlrpc_init_import' and 'ptlrpc_deactivate_import_nolock' in Lustre
+		value := i.Connection.Generation - 1
+		if value < 0 {
+			value = 0
+		}
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "", ""))
+	case importRPCsInFlightHelp:
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, i.RPCs.InFlight, "", ""))
+	case importRPCTimeoutsHelp:
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, i.RPCs.Timeouts, "", ""))
+	case importAverageWaitTimeHelp:
+		if i.RPCs.AvgWaittime == "" {
+			return nil, nil
+		}
+		value, err := parseLeadingNumber(i.RPCs.AvgWaittime)
+		if err != nil {
+			return nil, err
+		}
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "", ""))
+	case importServiceEstimateHelp:
+		estimates := make([]string, 0, len(i.ServiceEstimates))
+		for estimate := range i.ServiceEstimates {
+			estimates = append(estimates, estimate)
+		}
+		sort.Strings(estimates)
+		for _, estimate := range estimates {
+			value, err := parseLeadingNumber(i.ServiceEstimates[estimate])
+			if err != nil {
+				return nil, err
+			}
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "estimate", estimate))
+		}
+	case importWriteBytesPerRPCHelp, importWriteTimePerRPCHelp, importWriteThroughputHelp:
+		// Write averages are only reported by OSC imports which have seen bulk writes
+		values := map[string]*float64{
+			importWriteBytesPerRPCHelp: i.WriteDataAverages.BytesPerRPC,
+			importWriteTimePerRPCHelp:  i.WriteDataAverages.UsecPerRPC,
+			importWriteThroughputHelp:  i.WriteDataAverages.MBPerSec,
+		}
+		if value := values[helpText]; value != nil {
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, *value, "", ""))
+		}
+	}
+	return metricList, nil
+}
+
+func parsePrecreatedObjects(lastID string, nextID string) (float64, error) {
+	last, err := strconv.ParseFloat(strings.TrimSpace(lastID), 64)
+	if err != nil {
+		return 0, err
+	}
+	next, err := strconv.ParseFloat(strings.TrimSpace(nextID), 64)
+	if err != nil {
+		return 0, err
+	}
+	// 'prealloc_next_id' is one beyond the last used object ID
+	if last < next {
+		return 0, nil
+	}
+	return last - next + 1, nil
+}
+
+// parseLODValue parses the values of the LOD files, which may carry a unit such as '5 Sec' or '91%'.
+// The stripe offset is printed as an unsigned integer, hence '-1' shows up as 18446744073709551615.
+func parseLODValue(s string) (float64, error) {
+	fields := strings.Fields(s)
+	if len(fields) < 1 {
+		return 0, fmt.Errorf("no value found in %q", s)
+	}
+	value := strings.TrimSuffix(fields[0], "%")
+	if unsignedValue, err := strconv.ParseUint(value, 10, 64); err == nil {
+		return float64(int64(unsignedValue)), nil
+	}
+	return strconv.ParseFloat(value, 64)
+}
+
+func parseTargetObdText(targetFile string, helpText string, promName string) (metricList []lustreStatsMetric, err error) {
+	// LMV devices list MDTs, LOD and LOV devices list OSTs
+	targetLabel := "ost"
+	if helpText == lmvTargetActiveHelp {
+		targetLabel = "mdt"
+	}
+	for _, line := range strings.Split(targetFile, "\n") {
+		// Lines are in the following format:
+		// [index]: [target UUID] [ACTIVE|INACTIVE]
+		fields := strings.Fields(line)
+		if len(fields) != 3 {
+			continue
+		}
+		value := 0.0
+		if fields[2] == "ACTIVE" {
+			value = 1
+		}
+		target := strings.TrimSuffix(fields[1], "_UUID")
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, targetLabel, target))
+	}
+	return metricList, nil
+}
+
+// parseLOVFsName returns the filesystem name of a LOD or LOV device, such as 'lustrefs-MDT0000-mdtlov'
+// or 'lustrefs-clilov-ffff88105db50000'
+func parseLOVFsName(deviceName string) string {
+	if i := strings.Index(deviceName, "-clilov-"); i >= 0 {
+		return deviceName[:i]
+	}
+	if i := strings.LastIndex(deviceName, "-MDT"); i >= 0 {
+		return deviceName[:i]
+	}
+	return deviceName
+}
+
+// parsePoolMembers returns the OSTs listed in a pool file, one target UUID per line
+func parsePoolMembers(poolFile string) (members []string) {
+	for _, line := range strings.Split(poolFile, "\n") {
+		member := strings.TrimSpace(line)
+		if member == "" {
+			continue
+		}
+		members = append(members, strings.TrimSuffix(member, "_UUID"))
+	}
+	return members
+}
+
+func getServiceOperationMetrics(statsFile string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	for _, line := range strings.Split(statsFile, "\n") {
+		// Lines are in the following format:
+		// [operation] [number of samples] 'samples' [{units}] [minimum] [maximum] [sum] [sum of squares]
+		// [0]         [1]                 [2]       [3]       [4]       [5]       [6]   [7]
+		fields := strings.Fields(line)
+		if len(fields) < 7 || fields[2] != "samples" {
+			continue
+		}
+		// The first lines describe the service itself rather than an operation
+		operation := fields[0]
+		if strings.HasPrefix(operation, "req_") || operation == "reqbuf_avail" {
+			continue
+		}
+		index := 1
+		if helpText == serviceOperationTimeHelp {
+			// Some operations only count requests, which leaves no time to report
+			if fields[3] != "[usec]" {
+				continue
+			}
+			index = 6
+		}
+		result, err := strconv.ParseFloat(fields[index], 64)
+		if err != nil {
+			return nil, err
+		}
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, result, "operation", operation))
+	}
+	return metricList, nil
+}
+
+type lustreNRSPolicy struct {
+	Name   string  `yaml:"name"`
+	State  string  `yaml:"state"`
+	Queued float64 `yaml:"queued"`
+	Active float64 `yaml:"active"`
+}
+
+type lustreNRSPolicies struct {
+	RegularRequests      []lustreNRSPolicy `yaml:"regular_requests"`
+	HighPriorityRequests []lustreNRSPolicy `yaml:"high_priority_requests"`
+}
+
+func parseNRSPoliciesText(policiesFile string, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	var policies lustreNRSPolicies
+	if err := yaml.Unmarshal([]byte(policiesFile), &policies); err != nil {
+		return nil, err
+	}
+	// The high priority queue is only available for services supporting high priority requests
+	queues := []struct {
+		name     string
+		policies []lustreNRSPolicy
+	}{
+		{"regular", policies.RegularRequests},
+		{"high_priority", policies.HighPriorityRequests},
+	}
+	for _, queue := range queues {
+		for _, policy := range queue.policies {
+			var value float64
+			switch helpText {
+			case nrsPolicyStartedHelp:
+				if policy.State == "started" {
+					value = 1
+				}
+			case nrsPolicyQueuedHelp:
+				value = policy.Queued
+			case nrsPolicyActiveHelp:
+				value = policy.Active
+			default:
+				return nil, nil
+			}
+			metricList = append(metricList, lustreLabeledMetric{
+				lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", ""),
+				labels:            []string{"queue", "policy"},
+				labelValues:       []string{queue.name, policy.Name},
+			})
+		}
+	}
+	return metricList, nil
+}
+
+func parseNRSTBFRuleText(ruleFile string, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	queue := "regular"
+	cpt := ""
+	for _, line := range strings.Split(ruleFile, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "regular_requests:":
+			queue = "regular"
+		case line == "high_priority_requests:":
+			queue = "high_priority"
+		case strings.HasPrefix(line, "CPT "):
+			cpt = strings.TrimSuffix(strings.TrimPrefix(line, "CPT "), ":")
+		default:
+			// Rules are in the following format, the match expression depending on the TBF type:
+			// {rule name} {match expression} {rate}, ref {reference count}
+			match := nrsTBFRuleRegexPattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+			valueString := match[3]
+			if helpText == nrsTBFRuleRefCountHelp {
+				valueString = match[4]
+			}
+			value, err := strconv.ParseFloat(valueString, 64)
+			if err != nil {
+				return nil, err
+			}
+			metricList = append(metricList, lustreLabeledMetric{
+				lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", ""),
+				labels:            []string{"queue", "cpt", "rule", "match"},
+				labelValues:       []string{queue, cpt, match[1], match[2]},
+			})
+		}
+	}
+	return metricList, nil
+}
+
+// parseQuotaAccountingText parses the YAML content of the 'acct_user', 'acct_group' and 'acct_project' files:
+// usr_accounting:
+//   - id:      0
+//     usage:   { inodes:                  251, kbytes:            138926301 }
+func parseQuotaAccountingText(accountingFile string) (usage []lustreQuotaUsage, err error) {
+	// Targets without project quota support only print 'not supported'
+	if !strings.Contains(accountingFile, "_accounting:") {
+		return nil, nil
+	}
+	var accounting map[string][]struct {
+		ID    string `yaml:"id"`
+		Usage struct {
+			Inodes float64 `yaml:"inodes"`
+			Kbytes float64 `yaml:"kbytes"`
+		} `yaml:"usage"`
+	}
+	if err := yaml.Unmarshal([]byte(accountingFile), &accounting); err != nil {
+		return nil, err
+	}
+	quotaTypes := map[string]string{"usr_accounting": "user", "grp_accounting": "group", "prj_accounting": "project"}
+	for key, entries := range accounting {
+		quotaType, ok := quotaTypes[key]
+		if !ok {
+			continue
+		}
+		for _, entry := range entries {
+			usage = append(usage, lustreQuotaUsage{quotaType: quotaType, id: entry.ID, inodes: entry.Usage.Inodes, kbytes: entry.Usage.Kbytes})
+		}
+	}
+	return usage, nil
+}
+
+func quotaIDSelected(id string) bool {
+	if len(QuotaIDs) == 0 {
+		return true
+	}
+	for _, selectedID := range QuotaIDs {
+		if selectedID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTargetFsName returns the filesystem name of a target, e.g. 'lustrefs' for 'lustrefs-OST0000'
+func parseTargetFsName(target string) string {
+	if i := strings.LastIndex(target, "-"); i > 0 {
+		return target[:i]
+	}
+	return target
+}
+
+// parseQuotaSlaveInfoText parses the 'info' file of a quota slave:
+// quota enabled:  ug
+// conn to master: setup
+// space acct:     ugp
+// user uptodate:  glb[1],slv[1],reint[0]
+func parseQuotaSlaveInfoText(infoFile string, helpText string, promName string) (metricList []lustreStatsMetric, err error) {
+	quotaTypes := []struct {
+		name   string
+		letter string
+	}{
+		{"user", "u"},
+		{"group", "g"},
+		{"project", "p"},
+	}
+	info := map[string]string{}
+	for _, line := range strings.Split(infoFile, "\n") {
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+		info[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+	}
+	switch helpText {
+	case quotaSlaveConnectedHelp:
+		value, ok := info["conn to master"]
+		if !ok {
+			return nil, nil
+		}
+		var connected float64
+		if value == "setup" {
+			connected = 1
+		}
+		return []lustreStatsMetric{*newLustreStatsMetric(promName, helpText, connected, "", "")}, nil
+	case quotaSlaveEnforcedHelp, quotaSlaveAccountingHelp:
+		key := "quota enabled"
+		if helpText == quotaSlaveAccountingHelp {
+			key = "space acct"
+		}
+		value, ok := info[key]
+		if !ok {
+			return nil, nil
+		}
+		for _, quotaType := range quotaTypes {
+			var enabled float64
+			if value != "none" && strings.Contains(value, quotaType.letter) {
+				enabled = 1
+			}
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, enabled, "quota_type", quotaType.name))
+		}
+	case quotaSlaveGlobalUptodateHelp, quotaSlaveLocalUptodateHelp, quotaSlaveReintegrationHelp:
+		flag := map[string]string{
+			quotaSlaveGlobalUptodateHelp: "glb[",
+			quotaSlaveLocalUptodateHelp:  "slv[",
+			quotaSlaveReintegrationHelp:  "reint[",
+		}[helpText]
+		for _, quotaType := range quotaTypes {
+			value, ok := info[quotaType.name+" uptodate"]
+			if !ok {
+				continue
+			}
+			for _, field := range strings.Split(value, ",") {
+				if !strings.HasPrefix(field, flag) {
+					continue
+				}
+				result, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimPrefix(field, flag), "]"), 64)
+				if err != nil {
+					return nil, err
+				}
+				metricList = append(metricList, *newLustreStatsMetric(promName, helpText, result, "quota_type", quotaType.name))
+			}
+		}
+	}
+	return metricList, nil
+}
+
+// parseQMTPoolName splits the directory name of a quota pool, e.g. 'dt-0x0' or 'md-flash', into its type and name
+func parseQMTPoolName(poolDir string) (poolType string, pool string) {
+	i := strings.Index(poolDir, "-")
+	if i < 0 {
+		return poolDir, ""
+	}
+	return poolDir[:i], poolDir[i+1:]
+}
+
+// parseQMTGlobalIndexText parses the 'glb-usr', 'glb-grp' and 'glb-prj' files of a quota pool:
+// global_pool0_dt_usr
+//   - id:      0
+//     limits:  { hard:                    0, soft:                    0, granted:                    0, time:               604800 }
+//
+// Limits of 'dt' pools are given in kbytes, limits of 'md' pools in inodes.
+func parseQMTGlobalIndexText(indexFile string, poolType string, quotaType string, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	// The first line holds the name of the index and is no valid YAML
+	if i := strings.Index(indexFile, "\n"); i >= 0 {
+		indexFile = indexFile[i+1:]
+	}
+	var entries []struct {
+		ID     string `yaml:"id"`
+		Limits struct {
+			Hard    float64 `yaml:"hard"`
+			Soft    float64 `yaml:"soft"`
+			Granted float64 `yaml:"granted"`
+			Time    uint64  `yaml:"time"`
+		} `yaml:"limits"`
+	}
+	if err := yaml.Unmarshal([]byte(indexFile), &entries); err != nil {
+		return nil, err
+	}
+	// The limits of the data pools are given in kilobytes, those of the metadata pools in inodes
+	factor := 1.0
+	if poolType == "dt" {
+		factor = 1024.0
+	}
+	// Each limit is exported as bytes for the data pools and as inodes for the metadata pools
+	poolHelpTexts := map[string]string{
+		qmtHardLimitBytesHelp:  "dt",
+		qmtHardLimitInodesHelp: "md",
+		qmtSoftLimitBytesHelp:  "dt",
+		qmtSoftLimitInodesHelp: "md",
+		qmtGrantedBytesHelp:    "dt",
+		qmtGrantedInodesHelp:   "md",
+	}
+	if wantedPoolType, exists := poolHelpTexts[helpText]; exists && wantedPoolType != poolType {
+		return nil, nil
+	}
+	for _, entry := range entries {
+		// Newer Lustre versions keep flags in the upper 16 bits of the time
+		graceTime := float64(entry.Limits.Time & (1<<48 - 1))
+		// The limits of ID 0 are never enforced, its time holds the grace time of the pool
+		if entry.ID == "0" && helpText == qmtGracePeriodHelp {
+			metricList = append(metricList, lustreLabeledMetric{
+				lustreStatsMetric: *newLustreStatsMetric(promName, helpText, graceTime, "", ""),
+				labels:            []string{"quota_type"},
+				labelValues:       []string{quotaType},
+			})
+			continue
+		}
+		if !quotaIDSelected(entry.ID) {
+			continue
+		}
+		var value float64
+		switch helpText {
+		case qmtHardLimitBytesHelp, qmtHardLimitInodesHelp:
+			value = entry.Limits.Hard * factor
+		case qmtSoftLimitBytesHelp, qmtSoftLimitInodesHelp:
+			value = entry.Limits.Soft * factor
+		case qmtGrantedBytesHelp, qmtGrantedInodesHelp:
+			value = entry.Limits.Granted * factor
+		case qmtGrantedOverSoftHelp:
+			// The quota master only knows the amount granted to the slaves, not the accounted usage.
+			// It starts the grace time as soon as the granted amount exceeds the soft limit, which
+			// the slaves may request ahead of the actual usage.
+			if entry.Limits.Soft != 0 && entry.Limits.Granted > entry.Limits.Soft {
+				value = 1
+			}
+		case qmtGraceExpiryHelp:
+			// The time of IDs below their soft limit is not set
+			if entry.ID == "0" || graceTime == 0 {
+				continue
+			}
+			value = graceTime
+		default:
+			continue
+		}
+		idLabels, idLabelValues, idValue := resolveQuotaID(quotaType, entry.ID)
+		metricList = append(metricList, lustreLabeledMetric{
+			lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", ""),
+			labels:            append([]string{"quota_type", "id"}, idLabels...),
+			labelValues:       append([]string{quotaType, idValue}, idLabelValues...),
+		})
+	}
+	return metricList, nil
+}
+
+// parseQMTPoolInfoText parses the 'info' file of a quota pool. The '#slv' and '#lqe' keys
+// would be comments in YAML, hence the file is parsed line by line:
+// pool:
+//
+//	id: 0
+//	type: dt
+//	ref: 2
+//	least qunit: 1024
+//	usr:
+//	    #slv: 0
+//	    #lqe: 1
+func parseQMTPoolInfoText(infoFile string, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	quotaTypes := map[string]string{"usr": "user", "grp": "group", "prj": "project"}
+	quotaType := ""
+	for _, line := range strings.Split(infoFile, "\n") {
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+		key, valueString := strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		if name, ok := quotaTypes[key]; ok {
+			quotaType = name
+			continue
+		}
+		var labels, labelValues []string
+		switch {
+		case key == "least qunit" && helpText == qmtLeastQunitHelp:
+		case key == "#slv" && helpText == qmtPoolSlavesHelp, key == "#lqe" && helpText == qmtPoolEntriesHelp:
+			labels, labelValues = []string{"quota_type"}, []string{quotaType}
+		default:
+			continue
+		}
+		value, err := strconv.ParseFloat(valueString, 64)
+		if err != nil {
+			return nil, err
+		}
+		metricList = append(metricList, lustreLabeledMetric{
+			lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", ""),
+			labels:            labels,
+			labelValues:       labelValues,
+		})
+	}
+	return metricList, nil
+}
+
+// parseHSMFields splits the 'key=value' fields of a line of the HSM 'actions', 'active_requests' and 'agents' files:
+// lrh=[type=10680000 len=192 idx=1/3] fid=[0x200000400:0x1:0x0] ... action=ARCHIVE archive#=1 ... status=WAITING data=[]
+// Brackets may contain spaces, they are kept as part of the value.
+func parseHSMFields(line string) map[string]string {
+	fields := map[string]string{}
+	depth := 0
+	start := 0
+	for i := 0; i <= len(line); i++ {
+		if i < len(line) {
+			switch line[i] {
+			case '[':
+				depth++
+				continue
+			case ']':
+				depth--
+				continue
+			case ' ':
+				if depth > 0 {
+					continue
+				}
+			default:
+				continue
+			}
+		}
+		if field := line[start:i]; field != "" {
+			if j := strings.Index(field, "="); j > 0 {
+				fields[field[:j]] = field[j+1:]
+			}
+		}
+		start = i + 1
+	}
+	return fields
+}
+
+func parseHSMActionsText(actionsFile string, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	type actionKey struct{ action, status string }
+	var order []actionKey
+	counts := map[actionKey]float64{}
+	for _, line := range strings.Split(actionsFile, "\n") {
+		fields := parseHSMFields(line)
+		if fields["action"] == "" {
+			continue
+		}
+		key := actionKey{fields["action"], fields["status"]}
+		if _, ok := counts[key]; !ok {
+			order = append(order, key)
+		}
+		counts[key]++
+	}
+	for _, key := range order {
+		metricList = append(metricList, lustreLabeledMetric{
+			lustreStatsMetric: *newLustreStatsMetric(promName, helpText, counts[key], "", ""),
+			labels:            []string{"action", "status"},
+			labelValues:       []string{key.action, key.status},
+		})
+	}
+	return metricList, nil
+}
+
+// parseHSMAgentsText parses the copytool agents registered to the coordinator:
+// uuid=8c8d6b1a-5d35-6c5f-0f2b-6d4f1e9c2a11 archive_id=1,2 requests=[current:1 ok:27 errors:2]
+func parseHSMAgentsText(agentsFile string, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	var agents float64
+	for _, line := range strings.Split(agentsFile, "\n") {
+		fields := parseHSMFields(line)
+		if fields["uuid"] == "" {
+			continue
+		}
+		agents++
+		requests := map[string]float64{}
+		for _, counter := range strings.Fields(strings.Trim(fields["requests"], "[]")) {
+			i := strings.Index(counter, ":")
+			if i < 0 {
+				continue
+			}
+			value, err := strconv.ParseFloat(counter[i+1:], 64)
+			if err != nil {
+				return nil, err
+			}
+			requests[counter[:i]] = value
+		}
+		labels := []string{"uuid", "archive_id"}
+		labelValues := []string{fields["uuid"], fields["archive_id"]}
+		switch helpText {
+		case hsmAgentCurrentHelp:
+			metricList = append(metricList, lustreLabeledMetric{*newLustreStatsMetric(promName, helpText, requests["current"], "", ""), labels, labelValues})
+		case hsmAgentRequestsHelp:
+			for _, result := range []string{"ok", "errors"} {
+				metricList = append(metricList, lustreLabeledMetric{
+					lustreStatsMetric: *newLustreStatsMetric(promName, helpText, requests[result], "", ""),
+					labels:            append(labels, "result"),
+					labelValues:       append(labelValues, strings.TrimSuffix(result, "s")),
+				})
+			}
+		}
+	}
+	if helpText == hsmAgentsHelp {
+		metricList = append(metricList, lustreLabeledMetric{lustreStatsMetric: *newLustreStatsMetric(promName, helpText, agents, "", "")})
+	}
+	return metricList, nil
+}
+
+// parseHSMPolicyText parses the coordinator policies, the enabled ones being put in brackets:
+// NonBlockingRestore [NoRetryAction]
+func parseHSMPolicyText(policyFile string, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	for _, policy := range strings.Fields(policyFile) {
+		var value float64
+		if strings.HasPrefix(policy, "[") && strings.HasSuffix(policy, "]") {
+			value = 1
+		}
+		metricList = append(metricList, lustreLabeledMetric{
+			lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", ""),
+			labels:            []string{"policy"},
+			labelValues:       []string{strings.Trim(policy, "[]")},
+		})
+	}
+	return metricList, nil
+}
+
+// parseRecoveryStatusText parses the 'recovery_status' file of a target. Its content depends on the status:
+// status: RECOVERING
+// recovery_start: 1510605701
+// time_remaining: 120
+// connected_clients: 3/4
+// completed_clients: 2
+// evicted_clients: 1
+// Completed recoveries report the number of recoverable clients as 'completed_clients: 1/1'.
+func parseRecoveryStatusText(recoveryFile string, helpText string, promName string) (metricList []lustreStatsMetric, err error) {
+	status := map[string]string{}
+	for _, line := range strings.Split(recoveryFile, "\n") {
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+		status[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+	}
+	if status["status"] == "" {
+		return nil, nil
+	}
+	if helpText == recoveryStatusHelp {
+		for _, state := range recoveryStates {
+			value := 0.0
+			if state == status["status"] {
+				value = 1
+			}
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "status", state))
+		}
+		return metricList, nil
+	}
+	// keys maps the help text to the key holding the value, 'a/b' values are split into numerator and denominator
+	type recoveryValue struct {
+		key         string
+		denominator bool
+	}
+	keys := map[string]recoveryValue{
+		recoveryStartHelp:             {"recovery_start", false},
+		recoveryDurationHelp:          {"recovery_duration", false},
+		recoveryTimeRemainingHelp:     {"time_remaining", false},
+		recoveryTimeWaitedHelp:        {"time_waited", false},
+		recoveryCompletedClientsHelp:  {"completed_clients", false},
+		recoveryClientsHelp:           {"completed_clients", true},
+		recoveryConnectedClientsHelp:  {"connected_clients", false},
+		recoveryReqReplayClientsHelp:  {"req_replay_clients", false},
+		recoveryLockReplayClientsHelp: {"lock_replay_clients", false},
+		recoveryEvictedClientsHelp:    {"evicted_clients", false},
+		recoveryReplayedRequestsHelp:  {"replayed_requests", false},
+		recoveryQueuedRequestsHelp:    {"queued_requests", false},
+	}
+	k, ok := keys[helpText]
+	if !ok {
+		return nil, nil
+	}
+	// Running recoveries report the expected clients with the connected clients
+	if helpText == recoveryClientsHelp && strings.Contains(status["connected_clients"], "/") {
+		k.key = "connected_clients"
+	}
+	// Older Lustre versions misspell the lock replay clients
+	if _, ok := status["lock_replay_clients"]; !ok {
+		status["lock_replay_clients"] = status["lock_repay_clients"]
+	}
+	valueString, ok := status[k.key]
+	if !ok || valueString == "" {
+		return nil, nil
+	}
+	if i := strings.Index(valueString, "/"); i >= 0 {
+		if k.denominator {
+			valueString = valueString[i+1:]
+		} else {
+			valueString = valueString[:i]
+		}
+	} else if k.denominator {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(valueString, 64)
+	if err != nil {
+		return nil, err
+	}
+	return []lustreStatsMetric{*newLustreStatsMetric(promName, helpText, value, "", "")}, nil
+}
+
+// parseMGSLiveText parses the 'live/{fsname}' files of the MGS listing the registered targets of a filesystem
+// and the state of its imperative recovery, such as:
+// fsname: lustrefs
+// flags: 0x20     gen: 28
+// lustrefs-MDT0000
+// lustrefs-OST0000
+//
+// imperative_recovery_state:
+//
+//	state: full
+//	nonir_clients: 0
+//	nidtbl_version: 24
+//	notify_duration_total: 0.006756186
+//	notify_duation_max: 0.000853740
+//	notify_count: 14
+func parseMGSLiveText(liveFile string, helpText string, promName string) (metricList []lustreStatsMetric, err error) {
+	targets := map[string]float64{"mdt": 0, "ost": 0}
+	values := map[string]string{}
+	for _, line := range strings.Split(liveFile, "\n") {
+		line = strings.TrimSpace(line)
+		if i := strings.Index(line, ":"); i >= 0 {
+			values[strings.TrimSpace(line[:i])] = strings.TrimSpace(line[i+1:])
+		} else if strings.Contains(line, "-MDT") {
+			targets["mdt"]++
+		} else if strings.Contains(line, "-OST") {
+			targets["ost"]++
+		}
+	}
+	switch helpText {
+	case mgsTargetsHelp:
+		for _, targetType := range []string{"mdt", "ost"} {
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, targets[targetType], "target_type", targetType))
+		}
+		return metricList, nil
+	case mgsIRStateHelp:
+		if values["state"] == "" {
+			return nil, nil
+		}
+		for _, state := range mgsIRStates {
+			value := 0.0
+			if state == values["state"] {
+				value = 1
+			}
+			metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "state", state))
+		}
+		return metricList, nil
+	}
+	// Older Lustre versions misspell the maximum notify duration
+	if _, ok := values["notify_duration_max"]; !ok {
+		values["notify_duration_max"] = values["notify_duation_max"]
+	}
+	keys := map[string]string{
+		mgsIRNonIRClientsHelp:      "nonir_clients",
+		mgsIRNidtblVersionHelp:     "nidtbl_version",
+		mgsIRNotifyCountHelp:       "notify_count",
+		mgsIRNotifyDurationHelp:    "notify_duration_total",
+		mgsIRNotifyDurationMaxHelp: "notify_duration_max",
+	}
+	valueString := values[keys[helpText]]
+	if valueString == "" {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(valueString, 64)
+	if err != nil {
+		return nil, err
+	}
+	return []lustreStatsMetric{*newLustreStatsMetric(promName, helpText, value, "", "")}, nil
+}
+
+// parseSiteStatsText parses the 'site_stats' file of the object cache (lu_site) of a target, a single line of
+// '{busy}/{objects} {used buckets}/{buckets} {max search depth} {created} {hits} {misses} {races} {death races} {lru purged}':
+// 20/56 56/4096 1 104 8597582 103 0 0 0
+func parseSiteStatsText(statsFile string, helpText string, promName string) (metricList []lustreStatsMetric, err error) {
+	fields := strings.Fields(strings.Replace(statsFile, "/", " ", 2))
+	positions := map[string]int{
+		siteBusyObjectsHelp:    0,
+		siteObjectsHelp:        1,
+		siteBucketsUsedHelp:    2,
+		siteBucketsHelp:        3,
+		siteMaxSearchDepthHelp: 4,
+		siteCreatedHelp:        5,
+		siteCacheHitsHelp:      6,
+		siteCacheMissesHelp:    7,
+		siteCacheRacesHelp:     8,
+		siteDeathRacesHelp:     9,
+		siteLRUPurgedHelp:      10,
+	}
+	position, ok := positions[helpText]
+	if !ok || position >= len(fields) {
+		return nil, nil
+	}
+	value, err := strconv.ParseFloat(fields[position], 64)
+	if err != nil {
+		return nil, err
+	}
+	return []lustreStatsMetric{*newLustreStatsMetric(promName, helpText, value, "", "")}, nil
+}
+
+// parseHashStatsText parses the 'hash_stats' file listing the hash tables of a target with one line each:
+// name          cur   min   max theta t-min t-max flags rehash   count  maxdep maxdepb distribution
+// UUID_HASH     128   128  4096 0.039 0.500 2.000  0x284      0       5      -1      -1 4/0/0/0/0/0/0/0
+// A maximum depth of '-1' means it is not tracked for the hash table.
+func parseHashStatsText(hashFile string, helpText string, promName string) (metricList []lustreStatsMetric, err error) {
+	columns := map[string]string{
+		hashBucketsHelp:        "cur",
+		hashBucketsMaximumHelp: "max",
+		hashItemsHelp:          "count",
+		hashLoadFactorHelp:     "theta",
+		hashRehashesHelp:       "rehash",
+		hashMaxDepthHelp:       "maxdep",
+	}
+	lines := strings.Split(hashFile, "\n")
+	position := -1
+	for i, column := range strings.Fields(lines[0]) {
+		if column == columns[helpText] {
+			position = i
+		}
+	}
+	if position < 0 {
+		return nil, nil
+	}
+	for _, line := range lines[1:] {
+		fields := strings.Fields(line)
+		if len(fields) <= position {
+			continue
+		}
+		value, err := strconv.ParseFloat(fields[position], 64)
+		if err != nil {
+			return nil, err
+		}
+		if value < 0 {
+			continue
+		}
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, value, "hash", fields[0]))
+	}
+	return metricList, nil
+}
+
+// parseATTimeoutsText parses the adaptive timeout estimates of the 'timeouts' file of a service or an import,
+// as given by isService. Services list one line per CPU partition, of which the highest estimates are reported:
+//
+//	service : cur   1  worst  31 (at 1510777843, 4763s ago)   1   1   1   1
+//
+// Imports list the network latency and the estimates per portal:
+// last reply : 1510950459, 0s ago
+// network    : cur   1  worst   1 (at 1510766261, 184198s ago)   1   1   1   1
+// portal 28  : cur   1  worst   1 (at 1510766261, 184198s ago)   1   1   1   1
+func parseATTimeoutsText(timeoutsFile string, isService bool, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	var portals []string
+	current := map[string]float64{}
+	worst := map[string]float64{}
+	for _, line := range strings.Split(timeoutsFile, "\n") {
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+		fields := strings.Fields(line[i+1:])
+		if len(fields) < 4 || fields[0] != "cur" || fields[2] != "worst" {
+			continue
+		}
+		cur, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		wst, err := strconv.ParseFloat(fields[3], 64)
+		if err != nil {
+			return nil, err
+		}
+		portal := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line[:i]), "portal"))
+		if _, ok := current[portal]; !ok {
+			portals = append(portals, portal)
+		}
+		if cur >= current[portal] {
+			current[portal] = cur
+		}
+		if wst >= worst[portal] {
+			worst[portal] = wst
+		}
+	}
+	for _, portal := range portals {
+		var labels, labelValues []string
+		switch {
+		case isService:
+			if portal != "service" {
+				continue
+			}
+		case portal == "service":
+			continue
+		case helpText == importATNetworkLatencyHelp && portal == "network":
+		case helpText == importATEstimateHelp && portal != "network":
+			labels, labelValues = []string{"portal"}, []string{portal}
+		default:
+			continue
+		}
+		for _, estimate := range []string{"current", "worst"} {
+			value := current[portal]
+			if estimate == "worst" {
+				value = worst[portal]
+			}
+			metricList = append(metricList, lustreLabeledMetric{
+				lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", ""),
+				labels:            append(append([]string{}, labels...), "estimate"),
+				labelValues:       append(append([]string{}, labelValues...), estimate),
+			})
+		}
+	}
+	return metricList, nil
+}
+
+// parseLFSCKText parses the 'lfsck_layout' and 'lfsck_namespace' files of the LFSCK components and the
+// 'oi_scrub' file of the OSDs. All of them list 'key: value' lines, such as:
+// name: lfsck_layout
+// status: scanning-phase1
+// time_since_last_completed: 2418 seconds
+// checked_phase1: 1024
+// repaired_dangling: 2
+// run_time_phase1: 12 seconds
+// average_speed_phase1: 85 items/sec
+// Values not available yet are given as 'N/A' and skipped.
+func parseLFSCKText(lfsckFile string, helpText string, promName string) (metricList []lustreLabeledMetric, err error) {
+	var keys []string
+	values := map[string]string{}
+	for _, line := range strings.Split(lfsckFile, "\n") {
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+		key := strings.TrimSpace(line[:i])
+		keys = append(keys, key)
+		values[key] = strings.TrimSpace(line[i+1:])
+	}
+	// LFSCK components are labelled with their type, e.g. 'layout'
+	var labels, labelValues []string
+	if strings.HasPrefix(values["name"], "lfsck_") {
+		labels, labelValues = []string{"type"}, []string{strings.TrimPrefix(values["name"], "lfsck_")}
+	}
+	appendMetric := func(valueString string, extraLabel string, extraLabelValue string) error {
+		if valueString == "" || strings.HasPrefix(valueString, "N/A") {
+			return nil
+		}
+		value, err := parseLeadingNumber(valueString)
+		if err != nil {
+			return err
+		}
+		metric := lustreLabeledMetric{
+			lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", ""),
+			labels:            labels,
+			labelValues:       labelValues,
+		}
+		if extraLabel != "" {
+			metric.labels = append(append([]string{}, labels...), extraLabel)
+			metric.labelValues = append(append([]string{}, labelValues...), extraLabelValue)
+		}
+		metricList = append(metricList, metric)
+		return nil
+	}
+	// phaseKeys maps the help text to the key prefix of the values given per phase, e.g. 'checked_phase1'
+	phaseKeys := map[string]string{
+		lfsckCheckedHelp: "checked_",
+		lfsckFailedHelp:  "failed_",
+		lfsckRunTimeHelp: "run_time_",
+		lfsckSpeedHelp:   "average_speed_",
+	}
+	// singleKeys maps the help text to the key of single values
+	singleKeys := map[string]string{
+		lfsckSuccessHelp:          "success_count",
+		lfsckSinceCompletedHelp:   "time_since_last_completed",
+		oiScrubSuccessHelp:        "success_count",
+		oiScrubCheckedHelp:        "checked",
+		oiScrubUpdatedHelp:        "updated",
+		oiScrubFailedHelp:         "failed",
+		oiScrubRunTimeHelp:        "run_time",
+		oiScrubSpeedHelp:          "average_speed",
+		oiScrubSinceCompletedHelp: "time_since_last_completed",
+	}
+	switch helpText {
+	case lfsckStatusHelp, oiScrubStatusHelp:
+		if values["status"] == "" {
+			return nil, nil
+		}
+		states := lfsckStates
+		if helpText == oiScrubStatusHelp {
+			states = oiScrubStates
+		}
+		for _, state := range states {
+			value := 0.0
+			if state == values["status"] {
+				value = 1
+			}
+			if err := appendMetric(strconv.FormatFloat(value, 'f', -1, 64), "status", state); err != nil {
+				return nil, err
+			}
+		}
+	case lfsckRepairedHelp:
+		// The layout component names them 'repaired_{inconsistency}', the namespace component '{inconsistency}_repaired'
+		for _, key := range keys {
+			inconsistency := ""
+			if strings.HasPrefix(key, "repaired_") {
+				inconsistency = strings.TrimPrefix(key, "repaired_")
+			} else if strings.HasSuffix(key, "_repaired") {
+				inconsistency = strings.TrimSuffix(key, "_repaired")
+			}
+			if inconsistency == "" {
+				continue
+			}
+			if err := appendMetric(values[key], "inconsistency", inconsistency); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		if prefix, ok := phaseKeys[helpText]; ok {
+			for _, key := range keys {
+				if !strings.HasPrefix(key, prefix+"phase") {
+					continue
+				}
+				if err := appendMetric(values[key], "phase", strings.TrimPrefix(key, prefix+"phase")); err != nil {
+					return nil, err
+				}
+			}
+		} else if key, ok := singleKeys[helpText]; ok {
+			if err := appendMetric(values[key], "", ""); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return metricList, nil
+}
+
+func getLDLMStatsMetrics(statsFile string, promName string, helpText string) (metricList []lustreStatsMetric, err error) {
+	for _, line := range strings.Split(statsFile, "\n") {
+		// Lines are in the following format:
+		// [operation] [number of samples] 'samples' [{units}]
+		// [0]         [1]                 [2]       [3]
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[2] != "samples" {
+			continue
+		}
+		result, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return nil, err
+		}
+		// Operations are named after the LDLM request, e.g. 'ldlm_enqueue' or 'ldlm_bl_callback'
+		operation := strings.TrimPrefix(fields[0], "ldlm_")
+		metricList = append(metricList, *newLustreStatsMetric(promName, helpText, result, "operation", operation))
+	}
+	return metricList, nil
+}
+
+func parseStatsFile(helpText string, promName string, path string, hasMultipleVals bool) (metricList []lustreStatsMetric, err error) {
+	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+	statsFile := string(statsFileBytes[:])
+	var statsList []lustreStatsMetric
+	if hasMultipleVals {
+		statsList, err = getStatsOperationMetrics(statsFile, promName, helpText)
+	} else {
+		statsList, err = getStatsIOMetrics(statsFile, promName, helpText)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if statsList != nil {
+		metricList = append(metricList, statsList...)
+	}
+
+	return metricList, nil
+}
+
+func getJobStatsIOMetrics(jobBlock string, jobID string, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
+	// opMap matches the given helpText value with the placement of the numeric fields within each metric line.
+	// For example, the number of samples is the first number in the line and has a helpText of readSamplesHelp,
+	// hence the 'index' value of 0. 'pattern' is the regex capture pattern for the desired line.
+	opMap := map[string]multistatParsingStruct{
+		readSamplesHelp:  {index: 0, pattern: "read_bytes"},
+		readMinimumHelp:  {index: 1, pattern: "read_bytes"},
+		readMaximumHelp:  {index: 2, pattern: "read_bytes"},
+		readTotalHelp:    {index: 3, pattern: "read_bytes"},
 		writeSamplesHelp: {index: 0, pattern: "write_bytes"},
 		writeMinimumHelp: {index: 1, pattern: "write_bytes"},
 		writeMaximumHelp: {index: 2, pattern: "write_bytes"},
 		writeTotalHelp:   {index: 3, pattern: "write_bytes"},
 	}
-	// If the metric isn't located in the map, don't try to parse a value for it.
-	if _, exists := opMap[helpText]; !exists {
-		return nil, nil
+	// If the metric isn't located in the map, don't try to parse a value for it.
+	if _, exists := opMap[helpText]; !exists {
+		return nil, nil
+	}
+	pattern := opMap[helpText].pattern
+	opStat := regexCaptureString(pattern+": .*", jobBlock)
+	opNumbers := regexCaptureNumbers(opStat)
+	if len(opNumbers) < 1 {
+		return nil, nil
+	}
+	result, err := strconv.ParseFloat(strings.TrimSpace(opNumbers[opMap[helpText].index]), 64)
+	if err != nil {
+		return nil, err
+	}
+	if result == 0 {
+		return nil, nil
+	}
+	metricList = append(metricList,
+		lustreJobsMetric{jobID: jobID,
+			lustreStatsMetric: *newLustreStatsMetric(promName, helpText, result, "", ""),
+		})
+
+	return metricList, err
+}
+
+func getJobNum(jobBlock string) (jobID string, err error) {
+	jobID = regexCaptureJobid(jobBlock)
+	if jobID == "" {
+		return "", errors.New("No valid jobid found in block: " + jobBlock)
+	}
+	return strings.TrimSpace(jobID), nil
+}
+
+func getJobStatsOperationMetrics(jobBlock string, jobID string, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
+	operationSlice := []multistatParsingStruct{
+		{index: 0, pattern: "open"},
+		{index: 0, pattern: "close"},
+		{index: 0, pattern: "mknod"},
+		{index: 0, pattern: "link"},
+		{index: 0, pattern: "unlink"},
+		{index: 0, pattern: "mkdir"},
+		{index: 0, pattern: "rmdir"},
+		{index: 0, pattern: "rename"},
+		{index: 0, pattern: "getattr"},
+		{index: 0, pattern: "setattr"},
+		{index: 0, pattern: "getxattr"},
+		{index: 0, pattern: "setxattr"},
+		{index: 0, pattern: "statfs"},
+		{index: 0, pattern: "sync"},
+		{index: 0, pattern: "samedir_rename"},
+		{index: 0, pattern: "crossdir_rename"},
+		{index: 0, pattern: "punch"},
+		{index: 0, pattern: "destroy"},
+		{index: 0, pattern: "create"},
+		{index: 0, pattern: "get_info"},
+		{index: 0, pattern: "set_info"},
+		{index: 0, pattern: "quotactl"},
+	}
+	for _, operation := range operationSlice {
+		opStat := regexCaptureString(operation.pattern+": .*", jobBlock)
+		opNumbers := regexCaptureStrings("[0-9]*\\.[0-9]+|[0-9]+", opStat)
+		if len(opNumbers) < 1 {
+			continue
+		}
+		var result float64
+		result, err = strconv.ParseFloat(strings.TrimSpace(opNumbers[operation.index]), 64)
+		if err != nil {
+			return nil, err
+		}
+		if result == 0 {
+			continue
+		}
+		metricList = append(metricList,
+			lustreJobsMetric{jobID: jobID,
+				lustreStatsMetric: *newLustreStatsMetric(promName, helpText, result, "operation", operation.pattern),
+			})
+	}
+	return metricList, err
+}
+
+func parseJobStatsText(jobStats string, promName string, helpText string, hasMultipleVals bool) (metricList []lustreJobsMetric, err error) {
+	jobs := regexCaptureJobStats(jobStats)
+	if len(jobs) < 1 {
+		return nil, nil
+	}
+	var jobList []lustreJobsMetric
+	for _, job := range jobs {
+		jobID, err := getJobNum(job)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		if hasMultipleVals {
+			jobList, err = getJobStatsOperationMetrics(job, jobID, promName, helpText)
+		} else {
+			jobList, err = getJobStatsIOMetrics(job, jobID, promName, helpText)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if jobList != nil {
+			metricList = append(metricList, jobList...)
+		}
+	}
+	return metricList, nil
+}
+
+func (s *lustreProcFsSource) parseJobStats(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, string, float64, string, string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	jobStatsBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+
+	jobStatsFile := string(jobStatsBytes[:])
+
+	metricList, err := parseJobStatsText(jobStatsFile, promName, helpText, hasMultipleVals)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range metricList {
+		handler(nodeType, item.jobID, nodeName, item.lustreStatsMetric.title, item.lustreStatsMetric.help, item.lustreStatsMetric.value, item.lustreStatsMetric.extraLabel, item.lustreStatsMetric.extraLabelValue)
+	}
+	return nil
+}
+
+func (s *lustreProcFsSource) parseRPCStats(nodeType string, path string, directoryDepth int, helpText string, promName string, statsHandler func(string, string, string, string, float64, string, string), histogramHandler func(string, string, string, string, lustreHistogramMetric)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	statsFile := string(statsFileBytes[:])
+	metricList, histogramList, err := parseRPCStatsText(statsFile, helpText, promName)
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		statsHandler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+	}
+	for _, histogram := range histogramList {
+		histogramHandler(nodeType, nodeName, promName, helpText, histogram)
+	}
+	return nil
+}
+
+func (s *lustreProcFsSource) parseExtentsStats(nodeType string, statsFileName string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, lustreProcessHistogramMetric)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	statsFile := string(statsFileBytes[:])
+	var metricList []lustreProcessHistogramMetric
+	switch statsFileName {
+	case "extents_stats_per_process":
+		metricList, err = parseExtentsPerProcessText(statsFile, ClientExtentsTopProcesses)
+	default:
+		var histogramList []lustreHistogramMetric
+		if statsFileName == "offset_stats" {
+			histogramList, err = parseOffsetStatsText(statsFile)
+		} else {
+			histogramList, err = parseExtentsStatsText(statsFile)
+		}
+		for _, histogram := range histogramList {
+			metricList = append(metricList, lustreProcessHistogramMetric{lustreHistogramMetric: histogram})
+		}
+	}
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, promName, helpText, metric)
+	}
+	return nil
+}
+
+// parseImport takes the metrics from the 'import' file of the path, which is parsed only once per scrape,
+// as importFiles holds the files parsed so far
+func (s *lustreProcFsSource) parseImport(importFiles map[string]lustreImport, nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, string, string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	imp, parsed := importFiles[path]
+	if !parsed {
+		importFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		if imp, err = parseImportText(string(importFileBytes[:])); err != nil {
+			return err
+		}
+		importFiles[path] = imp
+	}
+	metricList, err := getImportMetrics(imp, helpText, promName)
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+	}
+	return nil
+}
+
+// ostCapacityFile returns the path of a capacity file of an OST. The OST is looked up in the OSD devices of
+// the node first, then in the OSP devices of an MDT and finally in the OSC devices of a client.
+func (s *lustreProcFsSource) ostCapacityFile(ost string, filename string) (string, error) {
+	for _, pattern := range []string{"osd-*/" + ost, "osp/" + ost + "-osc-*", "osc/" + ost + "-osc-*"} {
+		paths, err := filepath.Glob(filepath.Join(s.basePath, pattern, filename))
+		if err != nil {
+			return "", err
+		}
+		if len(paths) > 0 {
+			return paths[0], nil
+		}
+	}
+	return "", nil
+}
+
+func (s *lustreProcFsSource) parsePool(nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, string, float64, string)) (err error) {
+	pool, deviceName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	fsName := parseLOVFsName(deviceName)
+	poolFile, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	members := parsePoolMembers(string(poolFile))
+	if helpText == poolMemberHelp {
+		for _, member := range members {
+			handler(nodeType, fsName, pool, promName, helpText, 1, member)
+		}
+		return nil
+	}
+
+	capacityFiles := map[string]string{
+		poolCapacityHelp:  "kbytestotal",
+		poolFreeHelp:      "kbytesfree",
+		poolAvailableHelp: "kbytesavail",
+	}
+	filename, exists := capacityFiles[helpText]
+	if !exists {
+		return nil
+	}
+	// The capacity of a pool is only reported if the capacity of all its OSTs is known
+	total := 0.0
+	for _, member := range members {
+		capacityPath, err := s.ostCapacityFile(member, filename)
+		if err != nil {
+			return err
+		}
+		if capacityPath == "" {
+			return nil
+		}
+		value, err := ioutil.ReadFile(filepath.Clean(capacityPath))
+		if err != nil {
+			return err
+		}
+		convertedValue, err := strconv.ParseFloat(strings.TrimSpace(string(value)), 64)
+		if err != nil {
+			return err
+		}
+		total += convertedValue
+	}
+	handler(nodeType, fsName, pool, promName, helpText, total, "")
+	return nil
+}
+
+func (s *lustreProcFsSource) parseNRS(nodeType string, nrsFileName string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, []string, []string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	nrsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	nrsFile := string(nrsFileBytes[:])
+	var metricList []lustreLabeledMetric
+	if nrsFileName == "nrs_tbf_rule" {
+		metricList, err = parseNRSTBFRuleText(nrsFile, helpText, promName)
+	} else {
+		metricList, err = parseNRSPoliciesText(nrsFile, helpText, promName)
+	}
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.labels, metric.labelValues)
+	}
+	return nil
+}
+
+func (s *lustreProcFsSource) parseQuotaAccounting(nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, string, string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	accountingFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	usage, err := parseQuotaAccountingText(string(accountingFileBytes[:]))
+	if err != nil {
+		return err
+	}
+	for _, entry := range usage {
+		if !quotaIDSelected(entry.id) {
+			continue
+		}
+		if helpText == quotaUsedBytesHelp {
+			handler(nodeType, nodeName, promName, helpText, entry.kbytes*1024, entry.quotaType, entry.id)
+		} else {
+			handler(nodeType, nodeName, promName, helpText, entry.inodes, entry.quotaType, entry.id)
+		}
+	}
+	return nil
+}
+
+func (s *lustreProcFsSource) parseQuotaSlaveInfo(nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, string, string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
 	}
-	pattern := opMap[helpText].pattern
-	opStat := regexCaptureString(pattern+": .*", jobBlock)
-	opNumbers := regexCaptureNumbers(opStat)
-	if len(opNumbers) < 1 {
-		return nil, nil
+	infoFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
 	}
-	result, err := strconv.ParseFloat(strings.TrimSpace(opNumbers[opMap[helpText].index]), 64)
+	metricList, err := parseQuotaSlaveInfoText(string(infoFileBytes[:]), helpText, promName)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	if result == 0 {
-		return nil, nil
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
 	}
-	metricList = append(metricList,
-		lustreJobsMetric{jobID: jobID,
-			lustreStatsMetric: *newLustreStatsMetric(promName, helpText, result, "", ""),
-		})
-
-	return metricList, err
+	return nil
 }
 
-func getJobNum(jobBlock string) (jobID string, err error) {
-	jobID = regexCaptureJobid(jobBlock)
-	if jobID == "" {
-		return "", errors.New("No valid jobid found in block: " + jobBlock)
+func (s *lustreProcFsSource) parseQMT(nodeType string, qmtFileName string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, []string, []string)) (err error) {
+	fileName, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
 	}
-	return strings.TrimSpace(jobID), nil
+	qmtFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	qmtFile := string(qmtFileBytes[:])
+	poolType, pool := parseQMTPoolName(filepath.Base(filepath.Dir(path)))
+	var metricList []lustreLabeledMetric
+	switch qmtFileName {
+	case qmtGlobalIndexFiles:
+		quotaType := map[string]string{"glb-usr": "user", "glb-grp": "group", "glb-prj": "project"}[fileName]
+		if quotaType == "" {
+			return nil
+		}
+		metricList, err = parseQMTGlobalIndexText(qmtFile, poolType, quotaType, helpText, promName)
+	case qmtPoolInfo:
+		metricList, err = parseQMTPoolInfoText(qmtFile, helpText, promName)
+	case qmtSoftLeastQunit:
+		var value float64
+		value, err = strconv.ParseFloat(strings.TrimSpace(qmtFile), 64)
+		metricList = []lustreLabeledMetric{{lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", "")}}
+	}
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, append([]string{"pool_type", "pool"}, metric.labels...), append([]string{poolType, pool}, metric.labelValues...))
+	}
+	return nil
 }
 
-func getJobStatsOperationMetrics(jobBlock string, jobID string, promName string, helpText string) (metricList []lustreJobsMetric, err error) {
-	operationSlice := []multistatParsingStruct{
-		{index: 0, pattern: "open"},
-		{index: 0, pattern: "close"},
-		{index: 0, pattern: "mknod"},
-		{index: 0, pattern: "link"},
-		{index: 0, pattern: "unlink"},
-		{index: 0, pattern: "mkdir"},
-		{index: 0, pattern: "rmdir"},
-		{index: 0, pattern: "rename"},
-		{index: 0, pattern: "getattr"},
-		{index: 0, pattern: "setattr"},
-		{index: 0, pattern: "getxattr"},
-		{index: 0, pattern: "setxattr"},
-		{index: 0, pattern: "statfs"},
-		{index: 0, pattern: "sync"},
-		{index: 0, pattern: "samedir_rename"},
-		{index: 0, pattern: "crossdir_rename"},
-		{index: 0, pattern: "punch"},
-		{index: 0, pattern: "destroy"},
-		{index: 0, pattern: "create"},
-		{index: 0, pattern: "get_info"},
-		{index: 0, pattern: "set_info"},
-		{index: 0, pattern: "quotactl"},
+func (s *lustreProcFsSource) parseHSM(nodeType string, hsmFileName string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, []string, []string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
 	}
-	for _, operation := range operationSlice {
-		opStat := regexCaptureString(operation.pattern+": .*", jobBlock)
-		opNumbers := regexCaptureStrings("[0-9]*\\.[0-9]+|[0-9]+", opStat)
-		if len(opNumbers) < 1 {
-			continue
-		}
-		var result float64
-		result, err = strconv.ParseFloat(strings.TrimSpace(opNumbers[operation.index]), 64)
-		if err != nil {
-			return nil, err
-		}
-		if result == 0 {
-			continue
+	hsmFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	hsmFile := string(hsmFileBytes[:])
+	var metricList []lustreLabeledMetric
+	switch hsmFileName {
+	case "hsm/actions":
+		metricList, err = parseHSMActionsText(hsmFile, helpText, promName)
+	case "hsm/active_requests":
+		var requests float64
+		for _, line := range strings.Split(hsmFile, "\n") {
+			if strings.TrimSpace(line) != "" {
+				requests++
+			}
 		}
-		metricList = append(metricList,
-			lustreJobsMetric{jobID: jobID,
-				lustreStatsMetric: *newLustreStatsMetric(promName, helpText, result, "operation", operation.pattern),
+		metricList = []lustreLabeledMetric{{lustreStatsMetric: *newLustreStatsMetric(promName, helpText, requests, "", "")}}
+	case "hsm/agents":
+		metricList, err = parseHSMAgentsText(hsmFile, helpText, promName)
+	case "hsm/policy":
+		metricList, err = parseHSMPolicyText(hsmFile, helpText, promName)
+	case "hsm_control":
+		for _, state := range hsmCoordinatorStates {
+			value := 0.0
+			if state == strings.TrimSpace(hsmFile) {
+				value = 1
+			}
+			metricList = append(metricList, lustreLabeledMetric{
+				lustreStatsMetric: *newLustreStatsMetric(promName, helpText, value, "", ""),
+				labels:            []string{"state"},
+				labelValues:       []string{state},
 			})
+		}
 	}
-	return metricList, err
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.labels, metric.labelValues)
+	}
+	return nil
 }
 
-func parseJobStatsText(jobStats string, promName string, helpText string, hasMultipleVals bool) (metricList []lustreJobsMetric, err error) {
-	jobs := regexCaptureJobStats(jobStats)
-	if len(jobs) < 1 {
-		return nil, nil
+func (s *lustreProcFsSource) parseRecoveryStatus(nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, string, string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
 	}
-	var jobList []lustreJobsMetric
-	for _, job := range jobs {
-		jobID, err := getJobNum(job)
-		if err != nil {
-			log.Error(err)
-			continue
-		}
-		if hasMultipleVals {
-			jobList, err = getJobStatsOperationMetrics(job, jobID, promName, helpText)
-		} else {
-			jobList, err = getJobStatsIOMetrics(job, jobID, promName, helpText)
-		}
-		if err != nil {
-			return nil, err
-		}
-		if jobList != nil {
-			metricList = append(metricList, jobList...)
-		}
+	recoveryFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
 	}
-	return metricList, nil
+	metricList, err := parseRecoveryStatusText(string(recoveryFileBytes[:]), helpText, promName)
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+	}
+	return nil
 }
 
-func (s *lustreProcFsSource) parseJobStats(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, string, float64, string, string)) (err error) {
+func (s *lustreProcFsSource) parseMGS(nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, string, float64, string, string)) (err error) {
+	fsName, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	mgsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	if helpText == mgsFilesystemsHelp {
+		handler(nodeType, nodeName, "", promName, helpText, float64(len(strings.Fields(string(mgsFileBytes)))), "", "")
+		return nil
+	}
+	// The 'params' file holds the configuration set with 'lctl set_param -P', not a filesystem
+	if fsName == "params" {
+		return nil
+	}
+	metricList, err := parseMGSLiveText(string(mgsFileBytes[:]), helpText, promName)
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, fsName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+	}
+	return nil
+}
+
+func (s *lustreProcFsSource) parseLuSite(nodeType string, filename string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, string, string)) (err error) {
 	_, nodeName, err := parseFileElements(path, directoryDepth)
 	if err != nil {
 		return err
 	}
-	jobStatsBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	var metricList []lustreStatsMetric
+	if filename == "hash_stats" {
+		metricList, err = parseHashStatsText(string(statsFileBytes[:]), helpText, promName)
+	} else {
+		metricList, err = parseSiteStatsText(string(statsFileBytes[:]), helpText, promName)
+	}
 	if err != nil {
 		return err
 	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+	}
+	return nil
+}
 
-	jobStatsFile := string(jobStatsBytes[:])
+func (s *lustreProcFsSource) parseATTimeouts(nodeType string, path string, directoryDepth int, isService bool, helpText string, promName string, handler func(string, string, string, string, float64, []string, []string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	timeoutsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	metricList, err := parseATTimeoutsText(string(timeoutsFileBytes[:]), isService, helpText, promName)
+	if err != nil {
+		return err
+	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.labels, metric.labelValues)
+	}
+	return nil
+}
 
-	metricList, err := parseJobStatsText(jobStatsFile, promName, helpText, hasMultipleVals)
+func (s *lustreProcFsSource) parseLFSCK(nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, float64, []string, []string)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	lfsckFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	metricList, err := parseLFSCKText(string(lfsckFileBytes[:]), helpText, promName)
 	if err != nil {
 		return err
 	}
+	for _, metric := range metricList {
+		handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.labels, metric.labelValues)
+	}
+	return nil
+}
 
+func (s *lustreProcFsSource) parseRenameStats(nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, lustreHistogramMetric)) (err error) {
+	_, nodeName, err := parseFileElements(path, directoryDepth)
+	if err != nil {
+		return err
+	}
+	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return err
+	}
+	metricList, err := parseRenameStatsText(string(statsFileBytes[:]))
+	if err != nil {
+		return err
+	}
 	for _, item := range metricList {
-		handler(nodeType, item.jobID, nodeName, item.lustreStatsMetric.title, item.lustreStatsMetric.help, item.lustreStatsMetric.value, item.lustreStatsMetric.extraLabel, item.lustreStatsMetric.extraLabelValue)
+		handler(nodeType, nodeName, promName, helpText, item)
 	}
 	return nil
 }
 
-func (s *lustreProcFsSource) parseBRWStats(nodeType string, metricType string, path string, directoryDepth int, helpText string, promName string, hasMultipleVals bool, handler func(string, string, string, string, string, string, float64, string, string)) (err error) {
+func (s *lustreProcFsSource) parseBRWHistograms(nodeType string, path string, directoryDepth int, helpText string, promName string, handler func(string, string, string, string, lustreHistogramMetric)) (err error) {
 	_, nodeName, err := parseFileElements(path, directoryDepth)
 	if err != nil {
 		return err
 	}
 	metricBlocks := map[string]string{
-		pagesPerBlockRWHelp:    "pages per bulk r/w",
-		discontiguousPagesHelp: "discontiguous pages",
-		diskIOsInFlightHelp:    "disk I/Os in flight",
-		ioTimeHelp:             "I/O time",
-		diskIOSizeHelp:         "disk I/O size",
-		pagesPerRPCHelp:        "pages per rpc",
-		rpcsInFlightHelp:       "rpcs in flight",
-		offsetHelp:             "offset",
+		pagesPerBlockRWHelp:     "pages per bulk r/w",
+		discontiguousPagesHelp:  "discontiguous pages",
+		discontiguousBlocksHelp: "discontiguous blocks",
+		diskFragmentedIOsHelp:   "disk fragmented I/Os",
+		diskIOsInFlightHelp:     "disk I/Os in flight",
+		ioTimeHelp:              "I/O time",
+		diskIOSizeHelp:          "disk I/O size",
 	}
 	statsFileBytes, err := ioutil.ReadFile(filepath.Clean(path))
 	if err != nil {
@@ -730,23 +3431,12 @@ func (s *lustreProcFsSource) parseBRWStats(nodeType string, metricType string, p
 	}
 	statsFile := string(statsFileBytes[:])
 	block := regexCaptureString("(?ms:^"+metricBlocks[helpText]+".*?(\n\n|\\z))", statsFile)
-	metricList, err := splitBRWStats(block)
+	metricList, err := splitBRWHistograms(block)
 	if err != nil {
 		return err
 	}
-	extraLabel := ""
-	extraLabelValue := ""
-	if hasMultipleVals {
-		extraLabel = "type"
-		pathElements := strings.Split(path, "/")
-		extraLabelValue = pathElements[len(pathElements)-3]
-	}
 	for _, item := range metricList {
-		value, err := strconv.ParseFloat(item.value, 64)
-		if err != nil {
-			return err
-		}
-		handler(nodeType, item.operation, convertToBytes(item.size), nodeName, promName, helpText, value, extraLabel, extraLabelValue)
+		handler(nodeType, nodeName, promName, helpText, item)
 	}
 	return nil
 }
@@ -767,7 +3457,85 @@ func (s *lustreProcFsSource) parseFile(nodeType string, metricType string, path
 			return err
 		}
 		handler(nodeType, nodeName, promName, helpText, convertedValue, "", "")
-	case stats, mdStats, encryptPagePools:
+	case lod:
+		value, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		convertedValue, err := parseLODValue(string(value))
+		if err != nil {
+			return err
+		}
+		handler(nodeType, nodeName, promName, helpText, convertedValue, "", "")
+	case targetObd:
+		targetFile, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		metricList, err := parseTargetObdText(string(targetFile), helpText, promName)
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
+	case serviceStats:
+		statsFile, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		var metricList []lustreStatsMetric
+		if hasMultipleVals {
+			metricList, err = getServiceOperationMetrics(string(statsFile), promName, helpText)
+		} else {
+			metricList, err = getStatsIOMetrics(string(statsFile), promName, helpText)
+		}
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
+	case ldlmStats:
+		statsFile, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		metricList, err := getLDLMStatsMetrics(string(statsFile), promName, helpText)
+		if err != nil {
+			return err
+		}
+		for _, metric := range metricList {
+			handler(nodeType, nodeName, metric.title, metric.help, metric.value, metric.extraLabel, metric.extraLabelValue)
+		}
+	case precreated:
+		// The number of precreated objects is the difference between the last precreated object ID
+		// and the next ID to be used, which is read from the 'prealloc_next_id' file in the same directory
+		lastID, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		nextID, err := ioutil.ReadFile(filepath.Join(filepath.Dir(path), "prealloc_next_id"))
+		if err != nil {
+			return err
+		}
+		value, err := parsePrecreatedObjects(string(lastID), string(nextID))
+		if err != nil {
+			return err
+		}
+		handler(nodeType, nodeName, promName, helpText, value, "", "")
+	case checksumType:
+		value, err := ioutil.ReadFile(filepath.Clean(path))
+		if err != nil {
+			return err
+		}
+		// The selected algorithm is enclosed in brackets, e.g. 'crc32 adler [crc32c]'
+		algorithm := regexCaptureString(`\[\w+\]`, string(value))
+		if len(algorithm) == 0 {
+			return nil
+		}
+		handler(nodeType, nodeName, promName, helpText, 1, "algorithm", strings.Trim(algorithm, "[]"))
+	case stats, mdStats, encryptPagePools, readAheadStats, stataheadStats, oscCachedMB, oscStats, unstableStats:
 		metricList, err := parseStatsFile(helpText, promName, path, hasMultipleVals)
 		if err != nil {
 			return err
@@ -14,6 +14,7 @@
 package sources
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -278,3 +279,1323 @@ func TestJobStatBlockIds(t *testing.T) {
 		}
 	}
 }
+
+func TestSplitBRWHistograms(t *testing.T) {
+	testBlock := `disk I/O size          ios   % cum % |  ios         % cum %
+4K:		         2  50  50   |    0   0   0
+8K:		         0   0  50   |    1  25  25
+16K:		         2  50 100   |    0   0  25
+1M:		         0   0 100   |    3  75 100`
+
+	histograms, err := splitBRWHistograms(testBlock)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l := len(histograms); l != 2 {
+		t.Fatalf("Retrieved an unexpected number of histograms. Expected: %d, Got: %d", 2, l)
+	}
+
+	expected := []lustreHistogramMetric{
+		{
+			operation: "read",
+			count:     4,
+			buckets:   map[float64]uint64{4096: 2, 8192: 2, 16384: 4, 1048576: 4},
+		},
+		{
+			operation: "write",
+			count:     4,
+			buckets:   map[float64]uint64{4096: 0, 8192: 1, 16384: 1, 1048576: 4},
+		},
+	}
+	for i, histogram := range histograms {
+		if !reflect.DeepEqual(histogram, expected[i]) {
+			t.Fatalf("Retrieved an unexpected histogram. Expected: %+v, Got: %+v", expected[i], histogram)
+		}
+	}
+
+	histograms, err = splitBRWHistograms("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if histograms != nil {
+		t.Fatal("Retrieved histograms for an empty block. Expected nil.")
+	}
+}
+
+func TestParseRPCStatsText(t *testing.T) {
+	testOSCStats := `snapshot_time:         1510950459.787901292 (secs.nsecs)
+read RPCs in flight:  1
+write RPCs in flight: 6
+pending write pages:  1244
+pending read pages:   3
+
+			read			write
+pages per rpc         rpcs   % cum % |       rpcs   % cum %
+1:		         2  50  50   |       1537  50  50
+2:		         2  50 100   |       1537  50 100
+
+			read			write
+rpcs in flight        rpcs   % cum % |       rpcs   % cum %
+0:		         4 100 100   |          0   0   0
+1:		         0   0 100   |       3074 100 100
+`
+	testMDCStats := `snapshot_time:         1510950459.783304874 (secs.nsecs)
+modify_RPCs_in_flight:  2
+
+			modify
+rpcs in flight        rpcs   % cum %
+0:		         0   0   0
+1:		        90  45  45
+2:		       110  55 100
+`
+
+	metricList, histogramList, err := parseRPCStatsText(testOSCStats, currentRPCsInFlightHelp, "current_rpcs_in_flight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if histogramList != nil {
+		t.Fatal("Retrieved histograms for a header value. Expected nil.")
+	}
+	expectedMetrics := []lustreStatsMetric{
+		*newLustreStatsMetric("current_rpcs_in_flight", currentRPCsInFlightHelp, 1, "operation", "read"),
+		*newLustreStatsMetric("current_rpcs_in_flight", currentRPCsInFlightHelp, 6, "operation", "write"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	metricList, _, err = parseRPCStatsText(testOSCStats, pendingPagesHelp, "pending_pages")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics = []lustreStatsMetric{
+		*newLustreStatsMetric("pending_pages", pendingPagesHelp, 1244, "operation", "write"),
+		*newLustreStatsMetric("pending_pages", pendingPagesHelp, 3, "operation", "read"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	_, histogramList, err = parseRPCStatsText(testOSCStats, rpcsInFlightHelp, "rpcs_in_flight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedHistograms := []lustreHistogramMetric{
+		{operation: "read", count: 4, buckets: map[float64]uint64{0: 4, 1: 4}},
+		{operation: "write", count: 3074, buckets: map[float64]uint64{0: 0, 1: 3074}},
+	}
+	if !reflect.DeepEqual(histogramList, expectedHistograms) {
+		t.Fatalf("Retrieved unexpected histograms. Expected: %+v, Got: %+v", expectedHistograms, histogramList)
+	}
+
+	metricList, _, err = parseRPCStatsText(testMDCStats, currentRPCsInFlightHelp, "current_rpcs_in_flight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics = []lustreStatsMetric{
+		*newLustreStatsMetric("current_rpcs_in_flight", currentRPCsInFlightHelp, 2, "operation", "modify"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	_, histogramList, err = parseRPCStatsText(testMDCStats, rpcsInFlightHelp, "rpcs_in_flight")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedHistograms = []lustreHistogramMetric{
+		{operation: "modify", count: 200, buckets: map[float64]uint64{0: 0, 1: 90, 2: 200}},
+	}
+	if !reflect.DeepEqual(histogramList, expectedHistograms) {
+		t.Fatalf("Retrieved unexpected histograms. Expected: %+v, Got: %+v", expectedHistograms, histogramList)
+	}
+
+	_, histogramList, err = parseRPCStatsText(testMDCStats, pagesPerRPCHelp, "pages_per_rpc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if histogramList != nil {
+		t.Fatal("Retrieved histograms for a missing block. Expected nil.")
+	}
+}
+
+func TestGetReadAheadStatsMetrics(t *testing.T) {
+	testReadAheadStats := `snapshot_time             1510950459.776359249 secs.nsecs
+hits                      4026 samples [pages]
+misses                    223 samples [pages]
+readpage not consecutive  26 samples [pages]
+miss inside window        2 samples [pages]
+failed grab_cache_page    34 samples [pages]
+read but discarded        84 samples [pages]
+zero size window          3 samples [pages]
+read-ahead to EOF         5 samples [pages]
+hit max r-a issue         7 samples [pages]
+failed to reach end       220 samples [pages]
+`
+	testStataheadStats := `snapshot_time:         1510950459.776359249 (secs.nsecs)
+statahead total: 12
+statahead wrong: 1
+agl total: 8
+`
+	testCases := []struct {
+		statsFile string
+		helpText  string
+		expected  float64
+	}{
+		{testReadAheadStats, readAheadHitsHelp, 4026},
+		{testReadAheadStats, readAheadMissesHelp, 223},
+		{testReadAheadStats, readAheadNotConsecutiveHelp, 26},
+		{testReadAheadStats, readAheadMissInsideWindowHelp, 2},
+		{testReadAheadStats, readAheadFailedGrabCachePageHelp, 34},
+		{testReadAheadStats, readAheadReadButDiscardedHelp, 84},
+		{testReadAheadStats, readAheadZeroSizeWindowHelp, 3},
+		{testReadAheadStats, readAheadToEOFHelp, 5},
+		{testReadAheadStats, readAheadHitMaxIssueHelp, 7},
+		{testReadAheadStats, readAheadFailedToReachEndHelp, 220},
+		{testStataheadStats, stataheadTotalHelp, 12},
+		{testStataheadStats, stataheadWrongHelp, 1},
+		{testStataheadStats, stataheadAGLHelp, 8},
+	}
+
+	for _, tc := range testCases {
+		metricList, err := getStatsIOMetrics(tc.statsFile, "test", tc.helpText)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) != 1 || metricList[0].value != tc.expected {
+			t.Fatalf("Retrieved an unexpected value for %q. Expected: %f, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+
+	// Counters missing from the file are not exported
+	metricList, err := getStatsIOMetrics(testStataheadStats, "test", stataheadHitsHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metricList != nil {
+		t.Fatalf("Retrieved metrics for a missing counter. Expected nil, Got: %+v", metricList)
+	}
+}
+
+func TestParseExtentsStatsText(t *testing.T) {
+	testDisabled := `disabled
+ write anything to this file to activate, then '0' or 'disable' to deactivate
+`
+	histogramList, err := parseExtentsStatsText(testDisabled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if histogramList != nil {
+		t.Fatal("Retrieved histograms for disabled stats. Expected nil.")
+	}
+
+	testExtentsStats := "snapshot_time:         1510950459.776359249 (secs.nsecs)\n" +
+		"                           read       |                write\n" +
+		"      extents          calls    % cum%   |          calls    % cum%\n" +
+		"   0K -    4K :              2   20   20   |              0    0    0\n" +
+		"   4K -    8K :              8   80  100   |              1   33   33\n" +
+		" 512K -    1M\x00:              0    0  100   |              2   67  100\n"
+	histogramList, err = parseExtentsStatsText(testExtentsStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedHistograms := []lustreHistogramMetric{
+		{operation: "read", count: 10, buckets: map[float64]uint64{4095: 2, 8191: 10, 1048575: 10}},
+		{operation: "write", count: 3, buckets: map[float64]uint64{4095: 0, 8191: 1, 1048575: 3}},
+	}
+	if !reflect.DeepEqual(histogramList, expectedHistograms) {
+		t.Fatalf("Retrieved unexpected histograms. Expected: %+v, Got: %+v", expectedHistograms, histogramList)
+	}
+}
+
+func TestParseExtentsPerProcessText(t *testing.T) {
+	testExtentsStats := `snapshot_time:         1510950459.776359249 (secs.nsecs)
+                           read       |                write
+      extents          calls    % cum%   |          calls    % cum%
+
+PID: 1234
+   0K -    4K :              1  100  100   |              0    0    0
+
+PID: 5678
+   0K -    4K :              3   75   75   |              0    0    0
+   4K -    8K :              1   25  100   |              4  100  100
+
+PID: 91011
+   0K -    4K :              0    0    0   |              2  100  100
+`
+	histogramList, err := parseExtentsPerProcessText(testExtentsStats, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if histogramList != nil {
+		t.Fatal("Retrieved histograms while per-process histograms are disabled. Expected nil.")
+	}
+
+	histogramList, err = parseExtentsPerProcessText(testExtentsStats, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedHistograms := []lustreProcessHistogramMetric{
+		{pid: "5678", lustreHistogramMetric: lustreHistogramMetric{operation: "read", count: 4, buckets: map[float64]uint64{4095: 3, 8191: 4}}},
+		{pid: "5678", lustreHistogramMetric: lustreHistogramMetric{operation: "write", count: 4, buckets: map[float64]uint64{4095: 0, 8191: 4}}},
+		{pid: "91011", lustreHistogramMetric: lustreHistogramMetric{operation: "read", count: 0, buckets: map[float64]uint64{4095: 0}}},
+		{pid: "91011", lustreHistogramMetric: lustreHistogramMetric{operation: "write", count: 2, buckets: map[float64]uint64{4095: 2}}},
+	}
+	if !reflect.DeepEqual(histogramList, expectedHistograms) {
+		t.Fatalf("Retrieved unexpected histograms. Expected: %+v, Got: %+v", expectedHistograms, histogramList)
+	}
+}
+
+func TestParseOffsetStatsText(t *testing.T) {
+	testOffsetStats := `snapshot_time:         1510950459.776359249 (secs.nsecs)
+              R/W   PID   RANGE START   RANGE END   SMALLEST EXTENT   LARGEST EXTENT   OFFSET
+  R       1234              0           4096              4096              4096              0
+  R       1234           8192          12000              3808              3808              0
+  W       5678              0        1048576             65536            1048576              0
+`
+	histogramList, err := parseOffsetStatsText(testOffsetStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedHistograms := []lustreHistogramMetric{
+		{operation: "read", count: 2, buckets: map[float64]uint64{4096: 2, 1048576: 2}},
+		{operation: "write", count: 1, buckets: map[float64]uint64{4096: 0, 1048576: 1}},
+	}
+	if !reflect.DeepEqual(histogramList, expectedHistograms) {
+		t.Fatalf("Retrieved unexpected histograms. Expected: %+v, Got: %+v", expectedHistograms, histogramList)
+	}
+}
+
+func TestGetOSCStatsMetrics(t *testing.T) {
+	testOSCCachedMB := `used_mb: 5085
+busy_cnt: 5748
+reclaim: 5397
+`
+	testOSCStats := "snapshot_time:         1510950459.787841366 (secs.nsecs)\n" +
+		"lockless_write_bytes\t\t4096\n" +
+		"lockless_read_bytes\t\t8192\n" +
+		"lockless_truncate\t\t3\n"
+	testUnstableStats := `unstable_pages:                   12
+unstable_mb:                       1
+`
+	testCases := []struct {
+		statsFile string
+		helpText  string
+		expected  float64
+	}{
+		{testOSCCachedMB, oscCachedHelp, 5085},
+		{testOSCCachedMB, oscCachedBusyHelp, 5748},
+		{testOSCCachedMB, oscCachedReclaimHelp, 5397},
+		{testOSCStats, locklessWriteBytesHelp, 4096},
+		{testOSCStats, locklessReadBytesHelp, 8192},
+		{testOSCStats, locklessTruncateHelp, 3},
+		{testUnstableStats, unstablePagesHelp, 12},
+		{testUnstableStats, unstableMegabytesHelp, 1},
+	}
+
+	for _, tc := range testCases {
+		metricList, err := getStatsIOMetrics(tc.statsFile, "test", tc.helpText)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) != 1 || metricList[0].value != tc.expected {
+			t.Fatalf("Retrieved an unexpected value for %q. Expected: %f, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+}
+
+func TestParseImportText(t *testing.T) {
+	testImport := `import:
+    name: lustrefs-OST0000-osc-ffff88105db50000
+    target: lustrefs-OST0000_UUID
+    state: EVICTED
+    connect_flags: [ write_grant, server_lock, version ]
+    import_flags: [ replayable, pingable, connect_tried ]
+    connection:
+       failover_nids: [ 172.20.20.5@o2ib, 172.20.20.6@o2ib ]
+       current_connection: 172.20.20.5@o2ib
+       connection_attempts: 3
+       generation: 2
+       in-progress_invalidations: 0
+    rpcs:
+       inflight: 8
+       unregistering: 0
+       timeouts: 2
+       avg_waittime: 6054 usec
+    service_estimates:
+       services: 5 sec
+       network: 1 sec
+    write_data_averages:
+       bytes_per_rpc: 3585782
+       usec_per_rpc: 6055
+       MB_per_sec: 592.20
+`
+	imp, err := parseImportText(testImport)
+	if err != nil {
+		t.Fatal(err)
+	}
+	metricList, err := getImportMetrics(imp, importStateHelp, "import_state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricList) != len(importStates) {
+		t.Fatalf("Retrieved an unexpected number of states. Expected: %d, Got: %d", len(importStates), len(metricList))
+	}
+	for _, metric := range metricList {
+		if expected := metric.extraLabelValue == "EVICTED"; expected != (metric.value == 1) {
+			t.Fatalf("Retrieved an unexpected value for state %s: %f", metric.extraLabelValue, metric.value)
+		}
+	}
+
+	testCases := []struct {
+		helpText string
+		expected []lustreStatsMetric
+	}{
+		{importConnectionHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importConnectionHelp, 1, "nid", "172.20.20.5@o2ib")}},
+		{importFailoverNIDsHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importFailoverNIDsHelp, 2, "", "")}},
+		{importConnectionAttemptsHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importConnectionAttemptsHelp, 3, "", "")}},
+		{importInvalidationsHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importInvalidationsHelp, 1, "", "")}},
+		{importRPCsInFlightHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importRPCsInFlightHelp, 8, "", "")}},
+		{importRPCTimeoutsHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importRPCTimeoutsHelp, 2, "", "")}},
+		{importAverageWaitTimeHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importAverageWaitTimeHelp, 6054, "", "")}},
+		{importServiceEstimateHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", importServiceEstimateHelp, 1, "estimate", "network"),
+			*newLustreStatsMetric("test", importServiceEstimateHelp, 5, "estimate", "services"),
+		}},
+		{importWriteBytesPerRPCHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importWriteBytesPerRPCHelp, 3585782, "", "")}},
+		{importWriteTimePerRPCHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importWriteTimePerRPCHelp, 6055, "", "")}},
+		{importWriteThroughputHelp, []lustreStatsMetric{*newLustreStatsMetric("test", importWriteThroughputHelp, 592.2, "", "")}},
+	}
+	for _, tc := range testCases {
+		metricList, err := getImportMetrics(imp, tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(metricList, tc.expected) {
+			t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", tc.expected, metricList)
+		}
+	}
+}
+
+func TestParsePrecreatedObjects(t *testing.T) {
+	testCases := []struct {
+		lastID   string
+		nextID   string
+		expected float64
+	}{
+		{"97\n", "67\n", 31},
+		{"67\n", "67\n", 1},
+		{"66\n", "67\n", 0},
+	}
+	for _, tc := range testCases {
+		value, err := parsePrecreatedObjects(tc.lastID, tc.nextID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != tc.expected {
+			t.Fatalf("Retrieved an unexpected value. Expected: %f, Got: %f", tc.expected, value)
+		}
+	}
+
+	if _, err := parsePrecreatedObjects("abc", "67"); err == nil {
+		t.Fatal("Expected an error for an invalid object ID")
+	}
+}
+
+func TestParseLODValue(t *testing.T) {
+	testCases := []struct {
+		value    string
+		expected float64
+	}{
+		{"7\n", 7},
+		{"5 Sec\n", 5},
+		{"91%\n", 91},
+		{"18446744073709551615\n", -1},
+	}
+	for _, tc := range testCases {
+		value, err := parseLODValue(tc.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if value != tc.expected {
+			t.Fatalf("Retrieved an unexpected value for %q. Expected: %f, Got: %f", tc.value, tc.expected, value)
+		}
+	}
+}
+
+func TestParseTargetObdText(t *testing.T) {
+	testTargetObd := `0: lustrefs-OST0000_UUID ACTIVE
+1: lustrefs-OST0001_UUID INACTIVE
+`
+	metricList, err := parseTargetObdText(testTargetObd, lodTargetActiveHelp, "lod_target_active")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics := []lustreStatsMetric{
+		*newLustreStatsMetric("lod_target_active", lodTargetActiveHelp, 1, "ost", "lustrefs-OST0000"),
+		*newLustreStatsMetric("lod_target_active", lodTargetActiveHelp, 0, "ost", "lustrefs-OST0001"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	metricList, err = parseTargetObdText("0: lustrefs-MDT0000_UUID ACTIVE\n", lmvTargetActiveHelp, "lmv_target_active")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics = []lustreStatsMetric{
+		*newLustreStatsMetric("lmv_target_active", lmvTargetActiveHelp, 1, "mdt", "lustrefs-MDT0000"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+}
+
+func TestParsePoolMembers(t *testing.T) {
+	testPool := `lustrefs-OST0000_UUID
+lustrefs-OST0002_UUID
+`
+	expectedMembers := []string{"lustrefs-OST0000", "lustrefs-OST0002"}
+	members := parsePoolMembers(testPool)
+	if !reflect.DeepEqual(members, expectedMembers) {
+		t.Fatalf("Retrieved unexpected pool members. Expected: %v, Got: %v", expectedMembers, members)
+	}
+
+	if members = parsePoolMembers(""); members != nil {
+		t.Fatalf("Retrieved members for an empty pool. Expected nil, Got: %v", members)
+	}
+}
+
+func TestParseLOVFsName(t *testing.T) {
+	testCases := []struct {
+		deviceName string
+		expected   string
+	}{
+		{"lustrefs-MDT0000-mdtlov", "lustrefs"},
+		{"lustrefs-clilov-ffff88105db50000", "lustrefs"},
+		{"my-fs-MDT0001-mdtlov", "my-fs"},
+	}
+	for _, tc := range testCases {
+		if fsName := parseLOVFsName(tc.deviceName); fsName != tc.expected {
+			t.Fatalf("Retrieved an unexpected filesystem name. Expected: %s, Got: %s", tc.expected, fsName)
+		}
+	}
+}
+
+func TestGetServiceStatsMetrics(t *testing.T) {
+	testServiceStats := `snapshot_time             1510781853.000601035 secs.nsecs
+req_waittime              57267 samples [usec] 8 6033 4709981 1800908227
+req_qdepth                57267 samples [reqs] 0 3 234 464
+req_active                57267 samples [reqs] 1 7 119847 313587
+req_timeout               57267 samples [sec] 1 10 57294 57564
+reqbuf_avail              138779 samples [bufs] 63 64 8880960 568324992
+ldlm_ibits_enqueue        28 samples [reqs] 1 1 28 28
+mds_getattr               1 samples [usec] 37 37 37 1369
+`
+	testCases := []struct {
+		helpText string
+		expected float64
+	}{
+		{serviceRequestsHelp, 57267},
+		{serviceWaitTimeHelp, 4709981},
+		{serviceWaitTimeMaximumHelp, 6033},
+		{serviceQueueDepthHelp, 234},
+		{serviceQueueDepthMaximumHelp, 3},
+		{serviceActiveHelp, 119847},
+		{serviceActiveMaximumHelp, 7},
+		{serviceTimeoutMaximumHelp, 10},
+		{serviceBuffersMinimumHelp, 63},
+	}
+	for _, tc := range testCases {
+		metricList, err := getStatsIOMetrics(testServiceStats, "test", tc.helpText)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) != 1 || metricList[0].value != tc.expected {
+			t.Fatalf("Retrieved an unexpected value for %q. Expected: %f, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+
+	metricList, err := getServiceOperationMetrics(testServiceStats, "service_operations_total", serviceOperationsHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics := []lustreStatsMetric{
+		*newLustreStatsMetric("service_operations_total", serviceOperationsHelp, 28, "operation", "ldlm_ibits_enqueue"),
+		*newLustreStatsMetric("service_operations_total", serviceOperationsHelp, 1, "operation", "mds_getattr"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	metricList, err = getServiceOperationMetrics(testServiceStats, "service_operation_time_microseconds_total", serviceOperationTimeHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics = []lustreStatsMetric{
+		*newLustreStatsMetric("service_operation_time_microseconds_total", serviceOperationTimeHelp, 37, "operation", "mds_getattr"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+}
+
+func TestGetLDLMStatsMetrics(t *testing.T) {
+	testLDLMStats := `snapshot_time             1510781853.000601035 secs.nsecs
+ldlm_enqueue              28 samples [reqs]
+ldlm_cancel               14 samples [reqs]
+ldlm_bl_callback          1 samples [reqs]
+`
+	metricList, err := getLDLMStatsMetrics(testLDLMStats, "client_ldlm_stats_total", ldlmStatsHelp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics := []lustreStatsMetric{
+		*newLustreStatsMetric("client_ldlm_stats_total", ldlmStatsHelp, 28, "operation", "enqueue"),
+		*newLustreStatsMetric("client_ldlm_stats_total", ldlmStatsHelp, 14, "operation", "cancel"),
+		*newLustreStatsMetric("client_ldlm_stats_total", ldlmStatsHelp, 1, "operation", "bl_callback"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+}
+
+func TestParseNRSPoliciesText(t *testing.T) {
+	testPolicies := `regular_requests:
+  - name: fifo
+    state: started
+    fallback: yes
+    queued: 0                   
+    active: 1                   
+
+  - name: tbf
+    state: started
+    fallback: no
+    queued: 12                  
+    active: 4                   
+
+high_priority_requests:
+  - name: fifo
+    state: started
+    fallback: yes
+    queued: 0                   
+    active: 0                   
+
+`
+	metricList, err := parseNRSPoliciesText(testPolicies, nrsPolicyQueuedHelp, "nrs_policy_queued_requests")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics := []lustreLabeledMetric{
+		{*newLustreStatsMetric("nrs_policy_queued_requests", nrsPolicyQueuedHelp, 0, "", ""), []string{"queue", "policy"}, []string{"regular", "fifo"}},
+		{*newLustreStatsMetric("nrs_policy_queued_requests", nrsPolicyQueuedHelp, 12, "", ""), []string{"queue", "policy"}, []string{"regular", "tbf"}},
+		{*newLustreStatsMetric("nrs_policy_queued_requests", nrsPolicyQueuedHelp, 0, "", ""), []string{"queue", "policy"}, []string{"high_priority", "fifo"}},
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	metricList, err = parseNRSPoliciesText(testPolicies, nrsPolicyStartedHelp, "nrs_policy_started")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, metric := range metricList {
+		if metric.value != 1 {
+			t.Fatalf("Retrieved an unexpected state for policy %v. Expected: 1, Got: %f", metric.labelValues, metric.value)
+		}
+	}
+}
+
+func TestParseNRSTBFRuleText(t *testing.T) {
+	testRules := `regular_requests:
+CPT 0:
+dd_limit {dd.0} 100, ref 2
+default {*} 10000, ref 0
+CPT 1:
+default {*} 10000, ref 1
+high_priority_requests:
+CPT 0:
+default {*} 10000, ref 0
+`
+	metricList, err := parseNRSTBFRuleText(testRules, nrsTBFRuleRateHelp, "nrs_tbf_rule_rate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels := []string{"queue", "cpt", "rule", "match"}
+	expectedMetrics := []lustreLabeledMetric{
+		{*newLustreStatsMetric("nrs_tbf_rule_rate", nrsTBFRuleRateHelp, 100, "", ""), labels, []string{"regular", "0", "dd_limit", "{dd.0}"}},
+		{*newLustreStatsMetric("nrs_tbf_rule_rate", nrsTBFRuleRateHelp, 10000, "", ""), labels, []string{"regular", "0", "default", "{*}"}},
+		{*newLustreStatsMetric("nrs_tbf_rule_rate", nrsTBFRuleRateHelp, 10000, "", ""), labels, []string{"regular", "1", "default", "{*}"}},
+		{*newLustreStatsMetric("nrs_tbf_rule_rate", nrsTBFRuleRateHelp, 10000, "", ""), labels, []string{"high_priority", "0", "default", "{*}"}},
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	metricList, err = parseNRSTBFRuleText(testRules, nrsTBFRuleRefCountHelp, "nrs_tbf_rule_references")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricList) != 4 || metricList[0].value != 2 {
+		t.Fatalf("Retrieved unexpected metrics: %+v", metricList)
+	}
+
+	metricList, err = parseNRSTBFRuleText("", nrsTBFRuleRateHelp, "nrs_tbf_rule_rate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metricList != nil {
+		t.Fatalf("Retrieved metrics for a stopped TBF policy. Expected nil, Got: %+v", metricList)
+	}
+}
+
+func TestParseQuotaAccountingText(t *testing.T) {
+	testAccounting := `prj_accounting:
+- id:      0
+  usage:   { inodes:                  251, kbytes:            138926301 }
+- id:      1000
+  usage:   { inodes:                   12, kbytes:                   48 }
+`
+	usage, err := parseQuotaAccountingText(testAccounting)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedUsage := []lustreQuotaUsage{
+		{quotaType: "project", id: "0", inodes: 251, kbytes: 138926301},
+		{quotaType: "project", id: "1000", inodes: 12, kbytes: 48},
+	}
+	if !reflect.DeepEqual(usage, expectedUsage) {
+		t.Fatalf("Retrieved unexpected usage. Expected: %+v, Got: %+v", expectedUsage, usage)
+	}
+
+	usage, err = parseQuotaAccountingText("not supported\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usage != nil {
+		t.Fatalf("Expected no usage for unsupported quota types, Got: %+v", usage)
+	}
+}
+
+func TestQuotaIDSelected(t *testing.T) {
+	defer func() { QuotaIDs = nil }()
+	if !quotaIDSelected("1000") {
+		t.Fatal("Expected all IDs to be selected without an ID list")
+	}
+	QuotaIDs = []string{"0", "1000"}
+	if !quotaIDSelected("1000") || quotaIDSelected("1001") {
+		t.Fatalf("Unexpected ID selection for the ID list %v", QuotaIDs)
+	}
+}
+
+func TestParseTargetFsName(t *testing.T) {
+	for target, expected := range map[string]string{
+		"lustrefs-OST0000": "lustrefs",
+		"my-fs-MDT0001":    "my-fs",
+	} {
+		if fsName := parseTargetFsName(target); fsName != expected {
+			t.Fatalf("Retrieved an unexpected filesystem name for %q. Expected: %s, Got: %s", target, expected, fsName)
+		}
+	}
+}
+
+func TestParseQuotaSlaveInfoText(t *testing.T) {
+	testInfo := `target name:    lustrefs-OST0000
+pool ID:        0
+type:           dt
+quota enabled:  ug
+conn to master: not setup yet
+space acct:     ugp
+user uptodate:  glb[1],slv[1],reint[0]
+group uptodate: glb[0],slv[1],reint[1]
+project uptodate: glb[1],slv[0],reint[0]
+`
+	testCases := []struct {
+		helpText string
+		expected []lustreStatsMetric
+	}{
+		{quotaSlaveConnectedHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", quotaSlaveConnectedHelp, 0, "", ""),
+		}},
+		{quotaSlaveEnforcedHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", quotaSlaveEnforcedHelp, 1, "quota_type", "user"),
+			*newLustreStatsMetric("test", quotaSlaveEnforcedHelp, 1, "quota_type", "group"),
+			*newLustreStatsMetric("test", quotaSlaveEnforcedHelp, 0, "quota_type", "project"),
+		}},
+		{quotaSlaveAccountingHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", quotaSlaveAccountingHelp, 1, "quota_type", "user"),
+			*newLustreStatsMetric("test", quotaSlaveAccountingHelp, 1, "quota_type", "group"),
+			*newLustreStatsMetric("test", quotaSlaveAccountingHelp, 1, "quota_type", "project"),
+		}},
+		{quotaSlaveGlobalUptodateHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", quotaSlaveGlobalUptodateHelp, 1, "quota_type", "user"),
+			*newLustreStatsMetric("test", quotaSlaveGlobalUptodateHelp, 0, "quota_type", "group"),
+			*newLustreStatsMetric("test", quotaSlaveGlobalUptodateHelp, 1, "quota_type", "project"),
+		}},
+		{quotaSlaveReintegrationHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", quotaSlaveReintegrationHelp, 0, "quota_type", "user"),
+			*newLustreStatsMetric("test", quotaSlaveReintegrationHelp, 1, "quota_type", "group"),
+			*newLustreStatsMetric("test", quotaSlaveReintegrationHelp, 0, "quota_type", "project"),
+		}},
+	}
+	for _, tc := range testCases {
+		metricList, err := parseQuotaSlaveInfoText(testInfo, tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(metricList, tc.expected) {
+			t.Fatalf("Retrieved unexpected metrics for %q. Expected: %+v, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+}
+
+func TestParseQMTPoolName(t *testing.T) {
+	testCases := []struct {
+		poolDir  string
+		poolType string
+		pool     string
+	}{
+		{"dt-0x0", "dt", "0x0"},
+		{"md-flash", "md", "flash"},
+	}
+	for _, tc := range testCases {
+		poolType, pool := parseQMTPoolName(tc.poolDir)
+		if poolType != tc.poolType || pool != tc.pool {
+			t.Fatalf("Retrieved an unexpected pool for %q. Expected: %s %s, Got: %s %s", tc.poolDir, tc.poolType, tc.pool, poolType, pool)
+		}
+	}
+}
+
+func TestParseQMTGlobalIndexText(t *testing.T) {
+	testIndex := `global_pool0_dt_prj
+- id:      0
+  limits:  { hard:                    0, soft:                    0, granted:                    0, time:               604800 }
+- id:      1000
+  limits:  { hard:                 2048, soft:                 1024, granted:                 1536, time:           1510781853 }
+- id:      1001
+  limits:  { hard:                 2048, soft:                 1024, granted:                  512, time:                    0 }
+`
+	labels := []string{"quota_type", "id"}
+	testCases := []struct {
+		helpText string
+		expected []lustreLabeledMetric
+	}{
+		{qmtGracePeriodHelp, []lustreLabeledMetric{
+			{*newLustreStatsMetric("test", qmtGracePeriodHelp, 604800, "", ""), []string{"quota_type"}, []string{"project"}},
+		}},
+		{qmtHardLimitBytesHelp, []lustreLabeledMetric{
+			{*newLustreStatsMetric("test", qmtHardLimitBytesHelp, 0, "", ""), labels, []string{"project", "0"}},
+			{*newLustreStatsMetric("test", qmtHardLimitBytesHelp, 2097152, "", ""), labels, []string{"project", "1000"}},
+			{*newLustreStatsMetric("test", qmtHardLimitBytesHelp, 2097152, "", ""), labels, []string{"project", "1001"}},
+		}},
+		{qmtHardLimitInodesHelp, nil},
+		{qmtGrantedOverSoftHelp, []lustreLabeledMetric{
+			{*newLustreStatsMetric("test", qmtGrantedOverSoftHelp, 0, "", ""), labels, []string{"project", "0"}},
+			{*newLustreStatsMetric("test", qmtGrantedOverSoftHelp, 1, "", ""), labels, []string{"project", "1000"}},
+			{*newLustreStatsMetric("test", qmtGrantedOverSoftHelp, 0, "", ""), labels, []string{"project", "1001"}},
+		}},
+		{qmtGraceExpiryHelp, []lustreLabeledMetric{
+			{*newLustreStatsMetric("test", qmtGraceExpiryHelp, 1510781853, "", ""), labels, []string{"project", "1000"}},
+		}},
+	}
+	for _, tc := range testCases {
+		metricList, err := parseQMTGlobalIndexText(testIndex, "dt", "project", tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(metricList, tc.expected) {
+			t.Fatalf("Retrieved unexpected metrics for %q. Expected: %+v, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+}
+
+func TestParseQMTPoolInfoText(t *testing.T) {
+	testInfo := `pool:
+    id: 0
+    type: dt
+    ref: 2
+    least qunit: 1024
+    usr:
+        #slv: 4
+        #lqe: 12
+    grp:
+        #slv: 4
+        #lqe: 3
+`
+	metricList, err := parseQMTPoolInfoText(testInfo, qmtPoolEntriesHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics := []lustreLabeledMetric{
+		{*newLustreStatsMetric("test", qmtPoolEntriesHelp, 12, "", ""), []string{"quota_type"}, []string{"user"}},
+		{*newLustreStatsMetric("test", qmtPoolEntriesHelp, 3, "", ""), []string{"quota_type"}, []string{"group"}},
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	metricList, err = parseQMTPoolInfoText(testInfo, qmtLeastQunitHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics = []lustreLabeledMetric{
+		{lustreStatsMetric: *newLustreStatsMetric("test", qmtLeastQunitHelp, 1024, "", "")},
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+}
+
+func TestParseHSMFields(t *testing.T) {
+	fields := parseHSMFields("lrh=[type=10680000 len=192 idx=1/3] fid=[0x200000400:0x1:0x0] action=ARCHIVE archive#=1 status=WAITING data=[]")
+	expectedFields := map[string]string{
+		"lrh":      "[type=10680000 len=192 idx=1/3]",
+		"fid":      "[0x200000400:0x1:0x0]",
+		"action":   "ARCHIVE",
+		"archive#": "1",
+		"status":   "WAITING",
+		"data":     "[]",
+	}
+	if !reflect.DeepEqual(fields, expectedFields) {
+		t.Fatalf("Retrieved unexpected fields. Expected: %+v, Got: %+v", expectedFields, fields)
+	}
+}
+
+func TestParseHSMActionsText(t *testing.T) {
+	testActions := `lrh=[type=10680000 len=192 idx=1/3] fid=[0x200000400:0x1:0x0] action=ARCHIVE archive#=1 flags=0x0 status=WAITING data=[]
+lrh=[type=10680000 len=192 idx=1/4] fid=[0x200000400:0x2:0x0] action=RESTORE archive#=1 flags=0x0 status=STARTED data=[]
+lrh=[type=10680000 len=192 idx=1/5] fid=[0x200000400:0x3:0x0] action=ARCHIVE archive#=1 flags=0x0 status=WAITING data=[]
+`
+	metricList, err := parseHSMActionsText(testActions, hsmActionsHelp, "hsm_actions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels := []string{"action", "status"}
+	expectedMetrics := []lustreLabeledMetric{
+		{*newLustreStatsMetric("hsm_actions", hsmActionsHelp, 2, "", ""), labels, []string{"ARCHIVE", "WAITING"}},
+		{*newLustreStatsMetric("hsm_actions", hsmActionsHelp, 1, "", ""), labels, []string{"RESTORE", "STARTED"}},
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+}
+
+func TestParseHSMAgentsText(t *testing.T) {
+	testAgents := `uuid=8c8d6b1a-5d35-6c5f-0f2b-6d4f1e9c2a11 archive_id=1,2 requests=[current:1 ok:27 errors:2]
+uuid=2f4e1c3b-7a1d-4c2e-9b0f-3e5d7c9a1b22 archive_id=ANY requests=[current:0 ok:3 errors:0]
+`
+	metricList, err := parseHSMAgentsText(testAgents, hsmAgentsHelp, "hsm_agents")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricList) != 1 || metricList[0].value != 2 {
+		t.Fatalf("Retrieved an unexpected number of agents. Expected: 2, Got: %+v", metricList)
+	}
+
+	metricList, err = parseHSMAgentsText(testAgents, hsmAgentRequestsHelp, "hsm_agent_requests_total")
+	if err != nil {
+		t.Fatal(err)
+	}
+	labels := []string{"uuid", "archive_id", "result"}
+	expectedMetrics := []lustreLabeledMetric{
+		{*newLustreStatsMetric("hsm_agent_requests_total", hsmAgentRequestsHelp, 27, "", ""), labels, []string{"8c8d6b1a-5d35-6c5f-0f2b-6d4f1e9c2a11", "1,2", "ok"}},
+		{*newLustreStatsMetric("hsm_agent_requests_total", hsmAgentRequestsHelp, 2, "", ""), labels, []string{"8c8d6b1a-5d35-6c5f-0f2b-6d4f1e9c2a11", "1,2", "error"}},
+		{*newLustreStatsMetric("hsm_agent_requests_total", hsmAgentRequestsHelp, 3, "", ""), labels, []string{"2f4e1c3b-7a1d-4c2e-9b0f-3e5d7c9a1b22", "ANY", "ok"}},
+		{*newLustreStatsMetric("hsm_agent_requests_total", hsmAgentRequestsHelp, 0, "", ""), labels, []string{"2f4e1c3b-7a1d-4c2e-9b0f-3e5d7c9a1b22", "ANY", "error"}},
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+}
+
+func TestParseHSMPolicyText(t *testing.T) {
+	metricList, err := parseHSMPolicyText("NonBlockingRestore [NoRetryAction]\n", hsmPolicyEnabledHelp, "hsm_policy_enabled")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics := []lustreLabeledMetric{
+		{*newLustreStatsMetric("hsm_policy_enabled", hsmPolicyEnabledHelp, 0, "", ""), []string{"policy"}, []string{"NonBlockingRestore"}},
+		{*newLustreStatsMetric("hsm_policy_enabled", hsmPolicyEnabledHelp, 1, "", ""), []string{"policy"}, []string{"NoRetryAction"}},
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+}
+
+func TestParseRecoveryStatusText(t *testing.T) {
+	testRecovering := `status: RECOVERING
+recovery_start: 1510605701
+time_remaining: 120
+connected_clients: 3/4
+req_replay_clients: 1
+lock_repay_clients: 2
+completed_clients: 0
+evicted_clients: 1
+replayed_requests: 10
+queued_requests: 5
+next_transno: 8589934593
+`
+	testComplete := `status: COMPLETE
+recovery_start: 1510605726
+recovery_duration: 12
+completed_clients: 3/4
+replayed_requests: 10
+last_transno: 8589934592
+VBR: DISABLED
+IR: ENABLED
+`
+	testCases := []struct {
+		recoveryFile string
+		helpText     string
+		expected     float64
+	}{
+		{testRecovering, recoveryTimeRemainingHelp, 120},
+		{testRecovering, recoveryConnectedClientsHelp, 3},
+		{testRecovering, recoveryClientsHelp, 4},
+		{testRecovering, recoveryLockReplayClientsHelp, 2},
+		{testRecovering, recoveryEvictedClientsHelp, 1},
+		{testRecovering, recoveryQueuedRequestsHelp, 5},
+		{testComplete, recoveryDurationHelp, 12},
+		{testComplete, recoveryCompletedClientsHelp, 3},
+		{testComplete, recoveryClientsHelp, 4},
+	}
+	for _, tc := range testCases {
+		metricList, err := parseRecoveryStatusText(tc.recoveryFile, tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) != 1 || metricList[0].value != tc.expected {
+			t.Fatalf("Retrieved an unexpected value for %q. Expected: %f, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+
+	metricList, err := parseRecoveryStatusText(testComplete, recoveryTimeRemainingHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metricList != nil {
+		t.Fatalf("Expected no time remaining for a completed recovery, Got: %+v", metricList)
+	}
+
+	metricList, err = parseRecoveryStatusText(testRecovering, recoveryStatusHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics := []lustreStatsMetric{
+		*newLustreStatsMetric("test", recoveryStatusHelp, 0, "status", "INACTIVE"),
+		*newLustreStatsMetric("test", recoveryStatusHelp, 0, "status", "WAITING"),
+		*newLustreStatsMetric("test", recoveryStatusHelp, 1, "status", "RECOVERING"),
+		*newLustreStatsMetric("test", recoveryStatusHelp, 0, "status", "COMPLETE"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected metrics. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+}
+
+func TestParseLFSCKText(t *testing.T) {
+	testLayout := `name: lfsck_layout
+magic: 0xb1732fed
+version: 2
+status: scanning-phase1
+flags:
+param: all_targets
+last_completed_time: N/A
+time_since_last_completed: N/A
+success_count: 3
+repaired_dangling: 2
+repaired_unmatched_pair: 0
+checked_phase1: 1024
+checked_phase2: 0
+failed_phase1: 1
+failed_phase2: 0
+run_time_phase1: 12 seconds
+run_time_phase2: 0 seconds
+average_speed_phase1: 85 items/sec
+average_speed_phase2: N/A
+`
+	testNamespace := `name: lfsck_namespace
+status: completed
+time_since_last_completed: 2418 seconds
+dirent_repaired: 4
+linkea_repaired: 1
+`
+	testOIScrub := `name: OI_scrub
+status: completed
+time_since_last_completed: 2418 seconds
+checked: 259
+updated: 2
+failed: 0
+success_count: 1
+run_time: 8 seconds
+average_speed: 32 objects/sec
+`
+	testCases := []struct {
+		lfsckFile   string
+		helpText    string
+		labels      []string
+		labelValues []string
+		expected    float64
+	}{
+		{testLayout, lfsckSuccessHelp, []string{"type"}, []string{"layout"}, 3},
+		{testLayout, lfsckCheckedHelp, []string{"type", "phase"}, []string{"layout", "1"}, 1024},
+		{testLayout, lfsckFailedHelp, []string{"type", "phase"}, []string{"layout", "1"}, 1},
+		{testLayout, lfsckRunTimeHelp, []string{"type", "phase"}, []string{"layout", "1"}, 12},
+		{testLayout, lfsckSpeedHelp, []string{"type", "phase"}, []string{"layout", "1"}, 85},
+		{testNamespace, lfsckSinceCompletedHelp, []string{"type"}, []string{"namespace"}, 2418},
+		{testOIScrub, oiScrubCheckedHelp, nil, nil, 259},
+		{testOIScrub, oiScrubUpdatedHelp, nil, nil, 2},
+		{testOIScrub, oiScrubRunTimeHelp, nil, nil, 8},
+		{testOIScrub, oiScrubSpeedHelp, nil, nil, 32},
+	}
+	for _, tc := range testCases {
+		metricList, err := parseLFSCKText(tc.lfsckFile, tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) < 1 || metricList[0].value != tc.expected || !reflect.DeepEqual(metricList[0].labels, tc.labels) || !reflect.DeepEqual(metricList[0].labelValues, tc.labelValues) {
+			t.Fatalf("Retrieved an unexpected value for %q. Expected: %f %v %v, Got: %+v", tc.helpText, tc.expected, tc.labels, tc.labelValues, metricList)
+		}
+	}
+
+	metricList, err := parseLFSCKText(testLayout, lfsckSinceCompletedHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metricList != nil {
+		t.Fatalf("Expected no value for 'N/A', Got: %+v", metricList)
+	}
+
+	metricList, err = parseLFSCKText(testNamespace, lfsckRepairedHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricList) != 2 || metricList[0].labelValues[1] != "dirent" || metricList[0].value != 4 || metricList[1].labelValues[1] != "linkea" {
+		t.Fatalf("Retrieved unexpected repaired inconsistencies, Got: %+v", metricList)
+	}
+
+	metricList, err = parseLFSCKText(testLayout, lfsckStatusHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricList) != len(lfsckStates) {
+		t.Fatalf("Expected %d states, Got: %+v", len(lfsckStates), metricList)
+	}
+	for _, metric := range metricList {
+		expected := 0.0
+		if metric.labelValues[1] == "scanning-phase1" {
+			expected = 1
+		}
+		if metric.value != expected {
+			t.Fatalf("Retrieved an unexpected value for state %q. Expected: %f, Got: %f", metric.labelValues[1], expected, metric.value)
+		}
+	}
+}
+
+func TestParseMGSLiveText(t *testing.T) {
+	testLive := `fsname: lustrefs
+flags: 0x20     gen: 28
+lustrefs-MDT0000
+lustrefs-OST0000
+lustrefs-OST0001
+
+Secure RPC Config Rules:
+
+imperative_recovery_state:
+    state: partial
+    nonir_clients: 2
+    nidtbl_version: 24
+    notify_duration_total: 0.006756186
+    notify_duation_max: 0.000853740
+    notify_count: 14
+`
+	testCases := []struct {
+		helpText string
+		expected float64
+	}{
+		{mgsIRNonIRClientsHelp, 2},
+		{mgsIRNidtblVersionHelp, 24},
+		{mgsIRNotifyCountHelp, 14},
+		{mgsIRNotifyDurationHelp, 0.006756186},
+		{mgsIRNotifyDurationMaxHelp, 0.000853740},
+	}
+	for _, tc := range testCases {
+		metricList, err := parseMGSLiveText(testLive, tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) != 1 || metricList[0].value != tc.expected {
+			t.Fatalf("Retrieved an unexpected value for %q. Expected: %f, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+
+	metricList, err := parseMGSLiveText(testLive, mgsTargetsHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMetrics := []lustreStatsMetric{
+		*newLustreStatsMetric("test", mgsTargetsHelp, 1, "target_type", "mdt"),
+		*newLustreStatsMetric("test", mgsTargetsHelp, 2, "target_type", "ost"),
+	}
+	if !reflect.DeepEqual(metricList, expectedMetrics) {
+		t.Fatalf("Retrieved unexpected target counts. Expected: %+v, Got: %+v", expectedMetrics, metricList)
+	}
+
+	metricList, err = parseMGSLiveText(testLive, mgsIRStateHelp, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(metricList) != len(mgsIRStates) {
+		t.Fatalf("Expected %d states, Got: %+v", len(mgsIRStates), metricList)
+	}
+	for _, metric := range metricList {
+		expected := 0.0
+		if metric.extraLabelValue == "partial" {
+			expected = 1
+		}
+		if metric.value != expected {
+			t.Fatalf("Retrieved an unexpected value for state %q. Expected: %f, Got: %f", metric.extraLabelValue, expected, metric.value)
+		}
+	}
+}
+
+func TestParseRenameStatsText(t *testing.T) {
+	testRenameStats := `rename_stats:
+- snapshot_time:  1510781853.010473844
+- same_dir:
+      512bytes: { sample:   1, pct:  10, cum_pct:  10 }
+      4KB: { sample:   6, pct:  60, cum_pct:  70 }
+      8KB: { sample:   3, pct:  30, cum_pct: 100 }
+- crossdir_src:
+      1MB: { sample:   2, pct: 100, cum_pct: 100 }
+`
+	expected := []lustreHistogramMetric{
+		{operation: "samedir", count: 10, buckets: map[float64]uint64{512: 1, 4096: 7, 8192: 10}},
+		{operation: "crossdir_src", count: 2, buckets: map[float64]uint64{1048576: 2}},
+	}
+	metricList, err := parseRenameStatsText(testRenameStats)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(metricList, expected) {
+		t.Fatalf("Retrieved unexpected histograms. Expected: %+v, Got: %+v", expected, metricList)
+	}
+
+	metricList, err = parseRenameStatsText("rename_stats:\n- snapshot_time:  1510781853.010473844\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if metricList != nil {
+		t.Fatalf("Expected no histograms without renames, Got: %+v", metricList)
+	}
+}
+
+func TestParseATTimeoutsText(t *testing.T) {
+	testService := `   service : cur  10  worst  10 (at 1510605393, 177213s ago)   0   0   0   0 
+   service : cur   1  worst  31 (at 1510777843, 4763s ago)   1   1   1   1 
+`
+	testImport := `last reply : 1510950459, 0s ago
+network    : cur   1  worst   2 (at 1510766261, 184198s ago)   1   1   1   1 
+portal 28  : cur   1  worst   1 (at 1510766261, 184198s ago)   1   1   1   1 
+portal 6   : cur   3  worst  31 (at 1510777840, 172619s ago)   1   0   0   0 
+`
+	newMetric := func(helpText string, value float64, labels []string, labelValues []string) lustreLabeledMetric {
+		return lustreLabeledMetric{lustreStatsMetric: *newLustreStatsMetric("test", helpText, value, "", ""), labels: labels, labelValues: labelValues}
+	}
+	testCases := []struct {
+		timeoutsFile string
+		isService    bool
+		helpText     string
+		expected     []lustreLabeledMetric
+	}{
+		{testService, true, serviceATEstimateHelp, []lustreLabeledMetric{
+			newMetric(serviceATEstimateHelp, 10, []string{"estimate"}, []string{"current"}),
+			newMetric(serviceATEstimateHelp, 31, []string{"estimate"}, []string{"worst"}),
+		}},
+		{testImport, false, importATNetworkLatencyHelp, []lustreLabeledMetric{
+			newMetric(importATNetworkLatencyHelp, 1, []string{"estimate"}, []string{"current"}),
+			newMetric(importATNetworkLatencyHelp, 2, []string{"estimate"}, []string{"worst"}),
+		}},
+		{testImport, false, importATEstimateHelp, []lustreLabeledMetric{
+			newMetric(importATEstimateHelp, 1, []string{"portal", "estimate"}, []string{"28", "current"}),
+			newMetric(importATEstimateHelp, 1, []string{"portal", "estimate"}, []string{"28", "worst"}),
+			newMetric(importATEstimateHelp, 3, []string{"portal", "estimate"}, []string{"6", "current"}),
+			newMetric(importATEstimateHelp, 31, []string{"portal", "estimate"}, []string{"6", "worst"}),
+		}},
+		{testService, false, importATEstimateHelp, nil},
+		{testImport, true, serviceATEstimateHelp, nil},
+	}
+	for _, tc := range testCases {
+		metricList, err := parseATTimeoutsText(tc.timeoutsFile, tc.isService, tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(metricList, tc.expected) {
+			t.Fatalf("Retrieved unexpected estimates for %q. Expected: %+v, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+}
+
+func TestParseSiteStatsText(t *testing.T) {
+	testSiteStats := "91/99 88/4096 2 128 167 125 3 1 42\n"
+	testCases := []struct {
+		helpText string
+		expected float64
+	}{
+		{siteBusyObjectsHelp, 91},
+		{siteObjectsHelp, 99},
+		{siteBucketsUsedHelp, 88},
+		{siteBucketsHelp, 4096},
+		{siteMaxSearchDepthHelp, 2},
+		{siteCreatedHelp, 128},
+		{siteCacheHitsHelp, 167},
+		{siteCacheMissesHelp, 125},
+		{siteCacheRacesHelp, 3},
+		{siteDeathRacesHelp, 1},
+		{siteLRUPurgedHelp, 42},
+	}
+	for _, tc := range testCases {
+		metricList, err := parseSiteStatsText(testSiteStats, tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(metricList) != 1 || metricList[0].value != tc.expected {
+			t.Fatalf("Retrieved an unexpected value for %q. Expected: %f, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+}
+
+func TestParseHashStatsText(t *testing.T) {
+	testHashStats := `name                                                               cur   min   max theta t-min t-max flags rehash   count  maxdep maxdepb distribution
+UUID_HASH                                                          128   128  4096 0.070 0.500 2.000  0x284      0       9      -1      -1 4/0/0/0/0/0/0/0
+NID_STATS                                                          256   128  4096 0.031 0.500 2.000  0x284      1       4       2       7 4/0/0/0/0/0/0/0
+`
+	testCases := []struct {
+		helpText string
+		expected []lustreStatsMetric
+	}{
+		{hashBucketsHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", hashBucketsHelp, 128, "hash", "UUID_HASH"),
+			*newLustreStatsMetric("test", hashBucketsHelp, 256, "hash", "NID_STATS"),
+		}},
+		{hashLoadFactorHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", hashLoadFactorHelp, 0.070, "hash", "UUID_HASH"),
+			*newLustreStatsMetric("test", hashLoadFactorHelp, 0.031, "hash", "NID_STATS"),
+		}},
+		{hashItemsHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", hashItemsHelp, 9, "hash", "UUID_HASH"),
+			*newLustreStatsMetric("test", hashItemsHelp, 4, "hash", "NID_STATS"),
+		}},
+		{hashMaxDepthHelp, []lustreStatsMetric{
+			*newLustreStatsMetric("test", hashMaxDepthHelp, 2, "hash", "NID_STATS"),
+		}},
+	}
+	for _, tc := range testCases {
+		metricList, err := parseHashStatsText(testHashStats, tc.helpText, "test")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(metricList, tc.expected) {
+			t.Fatalf("Retrieved unexpected values for %q. Expected: %+v, Got: %+v", tc.helpText, tc.expected, metricList)
+		}
+	}
+}
@@ -92,8 +92,7 @@ func (s *lustreProcSysSource) generateLNETTemplates(filter string) {
 
 func newLustreProcSysSource() LustreSource {
 	var l lustreProcSysSource
-	// FIXME(yangchunxin): refactor procsys to kerneldebug
-	l.basePath = "/sys/kernel/debug" //filepath.Join(ProcLocation, "sys")
+	l.basePath = DebugLocation
 	if LnetEnabled != disabled {
 		l.generateLNETTemplates(LnetEnabled)
 	}
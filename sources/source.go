@@ -24,6 +24,9 @@ var ProcLocation = "/proc"
 // SysLocation is the source to pull sys files from.
 var SysLocation = "/sys"
 
+// DebugLocation is the source to pull the LNET files from, which are found in debugfs on recent Lustre versions.
+var DebugLocation = "/sys/kernel/debug"
+
 // If LctlCommandMode is true it enables execution of lctl command which is meant to be executed on a Lustre client node.
 // With false a local file is processed with test data.
 var LctlCommandMode = true
@@ -67,6 +70,23 @@ func gaugeMetric(labels []string, labelValues []string, name string, helpText st
 	)
 }
 
+// histogramMetric returns a histogram without a sum: Lustre only reports the number
+// of samples per bucket, so any sum derived from the bucket bounds would be made up.
+func histogramMetric(labels []string, labelValues []string, name string, helpText string, count uint64, buckets map[float64]uint64) prometheus.Metric {
+	return prometheus.MustNewConstHistogram(
+		prometheus.NewDesc(
+			prometheus.BuildFQName(Namespace, "", name),
+			helpText,
+			labels,
+			nil,
+		),
+		count,
+		0,
+		buckets,
+		labelValues...,
+	)
+}
+
 /*
 // XXX(yangchunxin): remove unused func
 func untypedMetric(labels []string, labelValues []string, name string, helpText string, value float64) prometheus.Metric {
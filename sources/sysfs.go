@@ -26,6 +26,9 @@ const (
 	// string mappings for 'health_check' values
 	healthCheckHealthy   string = "1"
 	healthCheckUnhealthy string = "0"
+
+	// ldlmNamespacesPath matches the LDLM namespaces of all types, e.g. 'mdt-lustrefs-MDT0000_UUID' or 'MGC172.20.20.1@o2ib'
+	ldlmNamespacesPath string = "ldlm/namespaces/*"
 )
 
 var (
@@ -89,6 +92,49 @@ func (s *lustreSysSource) generateOSTMetricTemplates(filter string) {
 	}
 }
 
+func (s *lustreSysSource) generateGenericMetricTemplates(filter string) {
+	metricMap := map[string][]lustreHelpStruct{
+		"": {
+			{"at_early_margin", "at_early_margin_seconds", "Time in seconds before the adaptive timeout of a request expires at which an early reply is sent", gaugeMetric, false, extended},
+			{"at_extra", "at_extra_seconds", "Time in seconds an early reply extends the adaptive timeout of a request by", gaugeMetric, false, extended},
+			{"at_history", "at_history_seconds", "Time in seconds the worst adaptive timeout estimate is remembered for", gaugeMetric, false, extended},
+			{"at_max", "at_maximum_seconds", "Maximum adaptive timeout in seconds, '0' if adaptive timeouts are disabled", gaugeMetric, false, core},
+			{"at_min", "at_minimum_seconds", "Minimum adaptive timeout in seconds", gaugeMetric, false, core},
+			{"bulk_timeout", "bulk_timeout_seconds", "Time in seconds to wait for a bulk transfer before it is considered failed", gaugeMetric, false, extended},
+			{"ldlm_timeout", "ldlm_timeout_seconds", "Time in seconds a client is given to reply to a lock callback of the server, if adaptive timeouts are disabled", gaugeMetric, false, extended},
+			{"timeout", "timeout_seconds", "Time in seconds a client waits for a reply of the server, if adaptive timeouts are disabled", gaugeMetric, false, core},
+		},
+		ldlmNamespacesPath: {
+			{"contended_locks", "ldlm_namespace_contended_locks", "Number of locks granted on a resource before it is considered contended", gaugeMetric, false, extended},
+			{"contention_seconds", "ldlm_namespace_contention_seconds", "Time in seconds a resource stays contended", gaugeMetric, false, extended},
+			{"early_lock_cancel", "ldlm_namespace_early_lock_cancel_enabled", "Returns '1' if early lock cancellation is enabled for the namespace", gaugeMetric, false, extended},
+			{"lock_count", "ldlm_namespace_locks", "Number of locks in the namespace", gaugeMetric, false, core},
+			{"lock_timeouts", "ldlm_namespace_lock_timeouts_total", "Total number of lock timeouts in the namespace", counterMetric, false, extended},
+			{"lock_unused_count", "ldlm_namespace_unused_locks", "Number of unused locks cached in the LRU of the namespace", gaugeMetric, false, core},
+			{"lru_max_age", "ldlm_namespace_lru_maximum_age_milliseconds", "Maximum age in milliseconds of unused locks in the LRU of the namespace", gaugeMetric, false, extended},
+			{"lru_size", "ldlm_namespace_lru_size", "Maximum number of unused locks in the LRU of the namespace, '0' if the LRU is sized dynamically", gaugeMetric, false, core},
+			{"resource_count", "ldlm_namespace_resources", "Number of resources in the namespace", gaugeMetric, false, core},
+
+			{"pool/cancel_rate", "ldlm_pool_cancel_rate", "Number of locks cancelled per second in the lock pool", gaugeMetric, false, extended},
+			{"pool/grant_plan", "ldlm_pool_grant_plan", "Number of planned lock grants per second in the lock pool", gaugeMetric, false, extended},
+			{"pool/grant_rate", "ldlm_pool_grant_rate", "Number of locks granted per second in the lock pool", gaugeMetric, false, extended},
+			{"pool/grant_speed", "ldlm_pool_grant_speed", "Difference between the lock grant and cancel rates of the lock pool", gaugeMetric, false, extended},
+			{"pool/granted", "ldlm_pool_granted", "Number of granted locks in the lock pool", gaugeMetric, false, core},
+			{"pool/limit", "ldlm_pool_limit", "Maximum number of locks in the lock pool", gaugeMetric, false, core},
+			{"pool/lock_volume_factor", "ldlm_pool_lock_volume_factor", "Multiplier applied to the lock volume of the lock pool", gaugeMetric, false, extended},
+			{"pool/server_lock_volume", "ldlm_pool_server_lock_volume", "Lock volume of the lock pool as computed by the server", gaugeMetric, false, extended},
+		},
+	}
+	for path := range metricMap {
+		for _, item := range metricMap[path] {
+			if filter == extended || item.priorityLevel == core {
+				newMetric := newLustreProcMetric(item.filename, item.promName, "generic", path, item.helpText, item.hasMultipleVals, item.metricFunc)
+				s.lustreProcMetrics = append(s.lustreProcMetrics, *newMetric)
+			}
+		}
+	}
+}
+
 func newLustreSysSource() LustreSource {
 	var l lustreSysSource
 	l.basePath = filepath.Join(SysLocation, "fs/lustre")
@@ -98,6 +144,9 @@ func newLustreSysSource() LustreSource {
 	if OstEnabled != disabled {
 		l.generateOSTMetricTemplates(OstEnabled)
 	}
+	if GenericEnabled != disabled {
+		l.generateGenericMetricTemplates(GenericEnabled)
+	}
 	return &l
 }
 
@@ -114,14 +163,37 @@ func (s *lustreSysSource) Update(ch chan<- prometheus.Metric) (err error) {
 			continue
 		}
 		for _, path := range paths {
-			switch metric.filename {
-			case "health_check":
+			switch {
+			case metric.filename == "health_check":
 				err = s.parseTextFile(metric.source, "health_check", path, directoryDepth, metric.helpText, metric.promName, func(nodeType string, nodeName string, name string, helpText string, value float64) {
 					ch <- metric.metricFunc([]string{"component", "target"}, []string{nodeType, nodeName}, name, helpText, value)
 				})
 				if err != nil {
 					return err
 				}
+			case metric.path == "":
+				// The tunables in the base directory, such as 'at_max' or 'timeout', apply to the whole node
+				err = s.parseFile(metric.source, single, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+					ch <- metric.metricFunc([]string{"component"}, []string{nodeType}, name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
+			case metric.path == ldlmNamespacesPath:
+				// The namespace name, e.g. 'mdt-lustrefs-MDT0000_UUID', is the parent directory of the metric file
+				pathElements := strings.Split(path, "/")
+				namespace := pathElements[len(pathElements)-2-directoryDepth]
+				if strings.HasPrefix(namespace, "filter-") {
+					// The namespaces of the OSTs are exported by the OST collector
+					continue
+				}
+				namespaceType := parseNamespaceType(namespace)
+				err = s.parseFile(metric.source, single, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
+					ch <- metric.metricFunc([]string{"component", "target", "namespace_type"}, []string{nodeType, strings.TrimPrefix(nodeName, "mdt-"), namespaceType}, name, helpText, value)
+				})
+				if err != nil {
+					return err
+				}
 			default:
 				err = s.parseFile(metric.source, single, path, directoryDepth, metric.helpText, metric.promName, metric.hasMultipleVals, func(nodeType string, nodeName string, name string, helpText string, value float64, extraLabel string, extraLabelValue string) {
 					if extraLabelValue == "" {
@@ -187,3 +259,24 @@ func (s *lustreSysSource) parseFile(nodeType string, metricType string, path str
 	}
 	return nil
 }
+
+// parseNamespaceType returns the type of an LDLM namespace based on its name
+func parseNamespaceType(namespace string) string {
+	switch {
+	case strings.HasPrefix(namespace, "mdt-"):
+		return "mdt"
+	case namespace == "MGS":
+		return "mgs"
+	case strings.HasPrefix(namespace, "MGC"):
+		return "mgc"
+	case strings.Contains(namespace, "-lwp-"):
+		return "lwp"
+	case strings.Contains(namespace, "-osp-"), strings.Contains(namespace, "-osc-MDT"):
+		return "osp"
+	case strings.Contains(namespace, "-osc-"):
+		return "osc"
+	case strings.Contains(namespace, "-mdc-"):
+		return "mdc"
+	}
+	return "unknown"
+}
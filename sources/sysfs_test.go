@@ -0,0 +1,40 @@
+// (C) Copyright 2017 Hewlett Packard Enterprise Development LP
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sources
+
+import (
+	"testing"
+)
+
+func TestParseNamespaceType(t *testing.T) {
+	testCases := []struct {
+		namespace string
+		expected  string
+	}{
+		{"mdt-lustrefs-MDT0000_UUID", "mdt"},
+		{"MGS", "mgs"},
+		{"MGC172.20.20.1@o2ib", "mgc"},
+		{"lustrefs-MDT0000-lwp-OST0000", "lwp"},
+		{"lustrefs-OST0000-osc-MDT0000", "osp"},
+		{"lustrefs-MDT0001-osp-MDT0000", "osp"},
+		{"lustrefs-OST0000-osc-ffff88105db50000", "osc"},
+		{"lustrefs-MDT0000-mdc-ffff88105db50000", "mdc"},
+		{"something-else", "unknown"},
+	}
+	for _, tc := range testCases {
+		if namespaceType := parseNamespaceType(tc.namespace); namespaceType != tc.expected {
+			t.Fatalf("Retrieved an unexpected namespace type for %s. Expected: %s, Got: %s", tc.namespace, tc.expected, namespaceType)
+		}
+	}
+}